@@ -1,7 +1,15 @@
 package cmd
 
 import (
+"errors"
 "fmt"
+"math"
+"os"
+"os/exec"
+"runtime"
+"sort"
+"strconv"
+"strings"
 
 "github.com/spf13/cobra"
 "github.com/sannticloud/sannti-cli/internal/client"
@@ -21,7 +29,7 @@ Long:  `Create, list, and manage Sannti Cloud compute instances (virtual machine
 var computeListCmd = &cobra.Command{
 Use:   "list",
 Short: "List compute instances",
-Long:  `List all compute instances, optionally filtered by region.`,
+Long:  `List all compute instances, optionally filtered by region and tag selector.`,
 RunE: func(cmd *cobra.Command, args []string) error {
 cfg, err := config.LoadConfig()
 if err != nil {
@@ -30,6 +38,12 @@ return err
 
 c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 
+selectorFlag, _ := cmd.Flags().GetString("selector")
+selector, err := parseSelector(selectorFlag)
+if err != nil {
+return err
+}
+
 region := regionFlag
 if region == "" {
 region = cfg.DefaultRegion
@@ -40,6 +54,14 @@ if err != nil {
 return fmt.Errorf("failed to list instances: %w", err)
 }
 
+var matched []models.Instance
+for _, inst := range instances {
+if matchesSelector(inst.Tags, selector) {
+matched = append(matched, inst)
+}
+}
+instances = matched
+
 if len(instances) == 0 {
 output.PrintInfo("No compute instances found")
 return nil
@@ -53,10 +75,10 @@ dataSlice[i] = inst
 return output.Print(
 dataSlice,
 output.Format(outputFormat),
-[]string{"UUID", "NAME", "STATE", "REGION", "IP ADDRESS"},
+[]string{"UUID", "NAME", "STATE", "REGION", "IP ADDRESS", "TAGS"},
 func(item interface{}) []string {
 inst := item.(models.Instance)
-return []string{inst.UUID, inst.Name, inst.State, inst.ZoneName, inst.IPAddress}
+return []string{inst.UUID, inst.Name, inst.State, inst.ZoneName, inst.IPAddress, formatTags(inst.Tags)}
 },
 )
 },
@@ -87,10 +109,20 @@ if err != nil {
 return fmt.Errorf("failed to get instance: %w", err)
 }
 
-return output.Print(
+// Volumes are informational; the instance is still shown if they cannot be listed
+volumes, volErr := c.ListInstanceVolumes(instance.UUID, region)
+if volErr != nil {
+output.PrintError(fmt.Sprintf("Warning: volumes unavailable: %v", volErr))
+}
+
+if output.Format(outputFormat) != output.FormatTable {
+return output.Print(instanceDetails{Instance: instance, Volumes: volumes}, output.Format(outputFormat), nil, nil)
+}
+
+if err := output.Print(
 instance,
-output.Format(outputFormat),
-[]string{"UUID", "NAME", "STATE", "VCPU", "MEMORY (MB)", "DISK (GB)", "NETWORK", "PRIVATE IP", "STATUS"},
+output.FormatTable,
+[]string{"UUID", "NAME", "STATE", "VCPU", "MEMORY (MB)", "DISK", "NETWORK", "PRIVATE IP", "STATUS"},
 func(item interface{}) []string {
 inst := item.(*models.Instance)
 
@@ -99,13 +131,7 @@ if vcpu == "" || vcpu == "0" {
 vcpu = "-"
 }
 
-disk := inst.VolumeSize
-if disk == "" || disk == "null" {
-disk = "-"
-} else {
-// Converter bytes para GB (aproximado)
-disk = disk + " bytes"
-}
+disk := formatByteString(inst.VolumeSize)
 
 network := inst.NetworkName
 if network == "" {
@@ -124,15 +150,49 @@ status = inst.State
 
 return []string{inst.UUID, inst.Name, inst.State, vcpu, inst.MemoryMB, disk, network, privateIP, status}
 },
+); err != nil {
+return err
+}
+
+fmt.Println()
+if volErr != nil {
+output.PrintInfo("Volumes unavailable")
+return nil
+}
+if len(volumes) == 0 {
+output.PrintInfo("No volumes attached")
+return nil
+}
+
+dataSlice := make([]interface{}, len(volumes))
+for i, vol := range volumes {
+dataSlice[i] = vol
+}
+
+return output.Print(
+dataSlice,
+output.FormatTable,
+[]string{"UUID", "NAME", "TYPE", "SIZE", "STATE", "ATTACHED TO", "OFFERING"},
+volumeRow,
 )
 },
 }
 
+// instanceDetails is the machine-readable output of compute get. Volumes is
+// null when they could not be listed.
+type instanceDetails struct {
+*models.Instance `yaml:",inline"`
+Volumes          []models.Volume `json:"volumes" yaml:"volumes"`
+}
+
 // computeCreateCmd creates a new instance
 var computeCreateCmd = &cobra.Command{
 Use:   "create",
 Short: "Create a compute instance",
-Long:  `Create a new compute instance with specified configuration.`,
+Long: `Create a new compute instance with specified configuration.
+
+With --estimate the estimated monthly cost is printed before the instance is
+created.`,
 RunE: func(cmd *cobra.Command, args []string) error {
 cfg, err := config.LoadConfig()
 if err != nil {
@@ -145,6 +205,8 @@ templateUUID, _ := cmd.Flags().GetString("image")
 offeringUUID, _ := cmd.Flags().GetString("size")
 networkUUID, _ := cmd.Flags().GetString("network")
 sshKey, _ := cmd.Flags().GetString("ssh-key")
+tagPairs, _ := cmd.Flags().GetStringArray("tag")
+disk, _ := cmd.Flags().GetInt64("disk")
 
 if region == "" {
 region = cfg.DefaultRegion
@@ -153,18 +215,47 @@ region = cfg.DefaultRegion
 if name == "" || templateUUID == "" || offeringUUID == "" || networkUUID == "" {
 return fmt.Errorf("required flags: --name, --image, --size, --network")
 }
+if disk < 0 {
+return fmt.Errorf("--disk cannot be negative")
+}
+
+tags, err := parseTags(tagPairs)
+if err != nil {
+return err
+}
 
 c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 
+if sshKey != "" {
+if _, err := c.GetSSHKey(sshKey, region); err != nil {
+return err
+}
+}
+
 req := models.CreateInstanceRequest{
 Name:                name,
 Region:              region,
 TemplateUUID:        templateUUID,
 ComputeOfferingUUID: offeringUUID,
 NetworkUUID:         networkUUID,
+RootDiskSize:        disk,
 SSHKeyName:          sshKey,
 }
 
+if estimate, _ := cmd.Flags().GetBool("estimate"); estimate {
+cost, err := c.EstimateInstanceCost(models.CostEstimateRequest{
+ComputeOfferingUUID: offeringUUID,
+DiskSize:            disk,
+Count:               1,
+Hours:               hoursPerMonth,
+Region:              region,
+})
+if err != nil {
+return err
+}
+printEstimateSummary(cost, fmt.Sprintf("instance '%s'", name))
+}
+
 output.PrintInfo(fmt.Sprintf("Creating compute instance '%s' in region '%s'...", name, region))
 
 instance, err := c.CreateInstance(req)
@@ -173,6 +264,14 @@ return fmt.Errorf("failed to create instance: %w", err)
 }
 
 output.PrintSuccess(fmt.Sprintf("Instance created: %s (UUID: %s)", instance.Name, instance.UUID))
+
+if len(tags) > 0 {
+if err := c.CreateTags("instance", instance.UUID, tags); err != nil {
+return err
+}
+output.PrintSuccess(fmt.Sprintf("Tagged instance with %s", formatTags(tags)))
+}
+
 return nil
 },
 }
@@ -233,8 +332,11 @@ return nil
 var computeDeleteCmd = &cobra.Command{
 Use:   "delete <uuid>",
 Short: "Delete a compute instance",
-Long:  `Delete a compute instance permanently. This action cannot be undone.`,
-Args:  cobra.ExactArgs(1),
+Long: `Delete a compute instance permanently. This action cannot be undone.
+
+You will be asked to type the instance name to confirm. Use --yes to skip
+the prompt, which is required when stdin is not a terminal.`,
+Args: cobra.ExactArgs(1),
 RunE: func(cmd *cobra.Command, args []string) error {
 cfg, err := config.LoadConfig()
 if err != nil {
@@ -243,10 +345,32 @@ return err
 
 c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 uuid := args[0]
+expunge, _ := cmd.Flags().GetBool("expunge")
+
+region := regionFlag
+if region == "" {
+region = cfg.DefaultRegion
+}
+
+instance, err := c.GetInstance(uuid, region)
+if err != nil {
+return fmt.Errorf("failed to get instance: %w", err)
+}
+
+if err := confirmDestructive("instance", instance.Name, []resourceDetail{
+{"Name", instance.Name},
+{"UUID", instance.UUID},
+{"Region", instance.ZoneName},
+{"State", instance.State},
+{"Public IP", instance.IPAddress},
+{"Private IP", instance.PrivateIP},
+}); err != nil {
+return err
+}
 
 output.PrintInfo(fmt.Sprintf("Deleting instance %s...", uuid))
 
-if err := c.DeleteInstance(uuid); err != nil {
+if err := c.DeleteInstance(uuid, expunge); err != nil {
 return fmt.Errorf("failed to delete instance: %w", err)
 }
 
@@ -255,12 +379,260 @@ return nil
 },
 }
 
+// computeSSHCmd opens an SSH session to an instance
+var computeSSHCmd = &cobra.Command{
+Use:   "ssh <name-or-uuid> [-- command...]",
+Short: "SSH into a compute instance",
+Long: `Open an SSH session to a compute instance using the system ssh client.
+
+The instance is resolved by name or UUID and its public IP is used unless
+--private is given. The login user is inferred from the image OS type when
+--user is not set. Anything after -- is run as a remote command.
+
+Defaults for --identity and --jump can be set with ssh_identity_file and
+ssh_jump_host in ~/.sannti/config.yaml (or SANNTI_SSH_IDENTITY_FILE and
+SANNTI_SSH_JUMP_HOST).`,
+Args: cobra.MinimumNArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+if dash := cmd.ArgsLenAtDash(); dash > 1 || (dash == -1 && len(args) > 1) {
+return fmt.Errorf("remote commands must follow --, e.g. sannti compute ssh %s -- uptime", args[0])
+}
+
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+user, _ := cmd.Flags().GetString("user")
+identity, _ := cmd.Flags().GetString("identity")
+jump, _ := cmd.Flags().GetString("jump")
+port, _ := cmd.Flags().GetInt("port")
+usePrivate, _ := cmd.Flags().GetBool("private")
+
+region := regionFlag
+if region == "" {
+region = cfg.DefaultRegion
+}
+
+instance, err := c.FindInstance(args[0], region)
+if err != nil {
+return err
+}
+
+host := instance.IPAddress
+if usePrivate {
+host = instance.PrivateIP
+}
+if host == "" {
+if usePrivate {
+return fmt.Errorf("instance %s has no private IP address", instance.Name)
+}
+return fmt.Errorf("instance %s has no public IP address, use --private (with --jump if needed)", instance.Name)
+}
+
+if user == "" {
+user = defaultSSHUser(c, instance, region)
+}
+if identity == "" {
+identity = cfg.SSHIdentityFile
+}
+if jump == "" {
+jump = cfg.SSHJumpHost
+}
+
+sshArgs := []string{"-p", fmt.Sprintf("%d", port)}
+if identity != "" {
+identity, err = expandPath(identity)
+if err != nil {
+return err
+}
+sshArgs = append(sshArgs, "-i", identity)
+}
+if jump != "" {
+sshArgs = append(sshArgs, "-J", jump)
+}
+sshArgs = append(sshArgs, fmt.Sprintf("%s@%s", user, host))
+sshArgs = append(sshArgs, args[1:]...)
+
+sshPath, err := exec.LookPath("ssh")
+if err != nil {
+return fmt.Errorf("ssh client not found in PATH: %w", err)
+}
+
+sshCmd := exec.Command(sshPath, sshArgs...)
+sshCmd.Stdin = os.Stdin
+sshCmd.Stdout = os.Stdout
+sshCmd.Stderr = os.Stderr
+
+if err := sshCmd.Run(); err != nil {
+var exitErr *exec.ExitError
+if errors.As(err, &exitErr) {
+// Propagate the remote exit status so scripts can rely on it
+os.Exit(exitErr.ExitCode())
+}
+return fmt.Errorf("failed to run ssh: %w", err)
+}
+
+return nil
+},
+}
+
+// computeConsoleCmd opens the web console of an instance
+var computeConsoleCmd = &cobra.Command{
+Use:   "console <uuid>",
+Short: "Open the web console of a compute instance",
+Long: `Open the web console of a compute instance in the default browser.
+
+Use this to reach an instance that has lost network access. Pass --print to
+only print the console URL and session token, e.g. on a headless machine. With
+-o json or -o yaml no browser is opened and only the URL and token are printed.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+uuid := args[0]
+printOnly, _ := cmd.Flags().GetBool("print")
+
+console, err := c.GetInstanceConsole(uuid)
+if err != nil {
+return err
+}
+
+if output.Format(outputFormat) != output.FormatTable {
+return output.Print(console, output.Format(outputFormat), nil, nil)
+}
+
+if !printOnly {
+if err := openBrowser(console.URL); err == nil {
+output.PrintSuccess(fmt.Sprintf("Console for instance %s opened in your browser", uuid))
+return nil
+}
+output.PrintInfo("Could not open a browser, open the URL below manually")
+}
+
+fmt.Println(console.URL)
+if console.Token != "" {
+fmt.Printf("Token: %s\n", console.Token)
+}
+return nil
+},
+}
+
+// computeResetPasswordCmd resets the password of an instance
+var computeResetPasswordCmd = &cobra.Command{
+Use:   "reset-password <uuid>",
+Short: "Reset the password of a compute instance",
+Long: `Reset the password of a compute instance and print the new password.
+
+The password is shown only once, so store it somewhere safe. Most images
+require the instance to be stopped before the password can be reset. With
+-o json or -o yaml only the instance UUID and password are printed.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+uuid := args[0]
+structured := output.Format(outputFormat) != output.FormatTable
+
+if !structured {
+output.PrintInfo(fmt.Sprintf("Resetting password for instance %s...", uuid))
+}
+
+password, err := c.ResetInstancePassword(uuid)
+if err != nil {
+return err
+}
+
+if structured {
+return output.Print(instancePassword{UUID: uuid, Password: password}, output.Format(outputFormat), nil, nil)
+}
+
+output.PrintSuccess("Password reset successfully. It will not be shown again:")
+fmt.Println(password)
+return nil
+},
+}
+
+// instancePassword is the machine-readable output of compute reset-password
+type instancePassword struct {
+UUID     string `json:"uuid" yaml:"uuid"`
+Password string `json:"password" yaml:"password"`
+}
+
+// openBrowser opens a URL with the platform's default handler
+func openBrowser(url string) error {
+var opener *exec.Cmd
+switch runtime.GOOS {
+case "darwin":
+opener = exec.Command("open", url)
+case "windows":
+opener = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+default:
+opener = exec.Command("xdg-open", url)
+}
+return opener.Start()
+}
+
+// defaultSSHUsers maps OS name fragments to their default cloud image user
+var defaultSSHUsers = []struct {
+match string
+user  string
+}{
+{"ubuntu", "ubuntu"},
+{"debian", "debian"},
+{"centos", "centos"},
+{"rocky", "rocky"},
+{"alma", "almalinux"},
+{"fedora", "fedora"},
+{"oracle", "opc"},
+{"amazon", "ec2-user"},
+{"red hat", "cloud-user"},
+{"rhel", "cloud-user"},
+{"windows", "Administrator"},
+}
+
+// defaultSSHUser infers the login user from the instance's image OS type,
+// falling back to the template name and finally to root
+func defaultSSHUser(c *client.Client, inst *models.Instance, region string) string {
+candidates := []string{inst.TemplateName}
+if templates, err := c.ListTemplates(region); err == nil {
+for _, tpl := range templates {
+if tpl.Name == inst.TemplateName {
+candidates = append([]string{tpl.OsTypeName}, candidates...)
+break
+}
+}
+}
+
+for _, candidate := range candidates {
+lower := strings.ToLower(candidate)
+for _, d := range defaultSSHUsers {
+if strings.Contains(lower, d.match) {
+return d.user
+}
+}
+}
+
+return "root"
+}
+
 // computeImagesCmd lists available images/templates
 var computeImagesCmd = &cobra.Command{
 Use:     "images",
 Aliases: []string{"templates"},
 Short:   "List available images",
-Long:    `List all available OS images/templates that can be used to create instances.`,
+Long: `List all available OS images/templates that can be used to create instances.
+
+--os matches the OS type or image name, case-insensitively.`,
 RunE: func(cmd *cobra.Command, args []string) error {
 cfg, err := config.LoadConfig()
 if err != nil {
@@ -279,6 +651,10 @@ if err != nil {
 return fmt.Errorf("failed to list images: %w", err)
 }
 
+osFilter, _ := cmd.Flags().GetString("os")
+readyOnly, _ := cmd.Flags().GetBool("ready-only")
+templates = filterTemplates(templates, osFilter, readyOnly)
+
 if len(templates) == 0 {
 output.PrintInfo("No images found")
 return nil
@@ -310,13 +686,49 @@ var computeSizesCmd = &cobra.Command{
 Use:     "sizes",
 Aliases: []string{"offerings", "flavors"},
 Short:   "List available compute sizes",
-Long:    `List all available compute offerings (VM sizes/flavors) with their specifications.`,
+Long: `List all available compute offerings (VM sizes/flavors) with their specifications.
+
+Memory values accept MB or a unit suffix, e.g. 8192, 8G or 512M. With --recommend
+only the smallest active size with at least --cpu cores and --memory is shown.`,
+Example: `  sannti compute sizes --min-cpu 4 --sort memory
+  sannti compute sizes --recommend --cpu 4 --memory 8G`,
 RunE: func(cmd *cobra.Command, args []string) error {
 cfg, err := config.LoadConfig()
 if err != nil {
 return err
 }
 
+minCPU, _ := cmd.Flags().GetInt("min-cpu")
+minMemoryFlag, _ := cmd.Flags().GetString("min-memory")
+activeOnly, _ := cmd.Flags().GetBool("active-only")
+sortBy, _ := cmd.Flags().GetString("sort")
+recommend, _ := cmd.Flags().GetBool("recommend")
+cpu, _ := cmd.Flags().GetInt("cpu")
+memoryFlag, _ := cmd.Flags().GetString("memory")
+
+minMemory, err := parseMemoryMB(minMemoryFlag)
+if err != nil {
+return fmt.Errorf("invalid --min-memory: %w", err)
+}
+memory, err := parseMemoryMB(memoryFlag)
+if err != nil {
+return fmt.Errorf("invalid --memory: %w", err)
+}
+
+if recommend {
+if cpu <= 0 && memory <= 0 {
+return fmt.Errorf("--recommend requires --cpu and/or --memory")
+}
+} else if cpu > 0 || memory > 0 {
+return fmt.Errorf("--cpu and --memory are only used with --recommend, use --min-cpu and --min-memory to filter")
+}
+
+switch sortBy {
+case "", "name", "cpu", "memory":
+default:
+return fmt.Errorf("invalid --sort %q: must be name, cpu or memory", sortBy)
+}
+
 c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 
 region := regionFlag
@@ -329,11 +741,32 @@ if err != nil {
 return fmt.Errorf("failed to list compute sizes: %w", err)
 }
 
+if recommend {
+best := recommendComputeOffering(offerings, cpu, memory)
+if best == nil {
+return fmt.Errorf("no active compute size has at least %d vCPU and %d MB memory", cpu, memory)
+}
+offerings = []models.ComputeOffering{*best}
+} else {
+offerings = filterComputeOfferings(offerings, minCPU, minMemory, activeOnly)
+sortComputeOfferings(offerings, sortBy)
+}
+
 if len(offerings) == 0 {
 output.PrintInfo("No compute sizes found")
 return nil
 }
 
+// Prices are informational; sizes are still listed if pricing is unavailable
+prices := map[string]models.ComputeOfferingPrice{}
+list, err := c.ListComputeOfferingPrices(region)
+if err != nil {
+output.PrintError(fmt.Sprintf("Warning: prices unavailable: %v", err))
+}
+for _, p := range list {
+prices[p.ComputeOfferingUUID] = p
+}
+
 dataSlice := make([]interface{}, len(offerings))
 for i, off := range offerings {
 dataSlice[i] = off
@@ -342,18 +775,27 @@ dataSlice[i] = off
 return output.Print(
 dataSlice,
 output.Format(outputFormat),
-[]string{"UUID", "NAME", "CPU", "MEMORY (MB)", "ACTIVE"},
+[]string{"UUID", "NAME", "CPU", "CLOCK (MHZ)", "MEMORY (MB)", "STORAGE", "PRICE/HOUR", "PRICE/MONTH", "ACTIVE"},
 func(item interface{}) []string {
 off := item.(models.ComputeOffering)
 active := "no"
 if off.IsActive {
 active = "yes"
 }
+hourly, monthly := "-", "-"
+if p, ok := prices[off.UUID]; ok {
+hourly = formatPrice(p.HourlyPrice, p.Currency)
+monthly = formatPrice(p.MonthlyPrice, p.Currency)
+}
 return []string{
 off.UUID,
 off.Name,
 off.NumberOfCores,
+valueOr(off.ClockSpeed, "-"),
 off.Memory,
+valueOr(off.StorageType, "-"),
+hourly,
+monthly,
 active,
 }
 },
@@ -361,6 +803,129 @@ active,
 },
 }
 
+// filterTemplates keeps the images whose OS type or name contains osFilter,
+// and only ready ones when readyOnly is set
+func filterTemplates(templates []models.Template, osFilter string, readyOnly bool) []models.Template {
+osFilter = strings.ToLower(osFilter)
+
+var filtered []models.Template
+for _, tpl := range templates {
+if readyOnly && !tpl.IsReady {
+continue
+}
+if osFilter != "" &&
+!strings.Contains(strings.ToLower(tpl.OsTypeName), osFilter) &&
+!strings.Contains(strings.ToLower(tpl.Name), osFilter) {
+continue
+}
+filtered = append(filtered, tpl)
+}
+return filtered
+}
+
+// offeringCPU returns the number of cores of an offering, 0 if unknown
+func offeringCPU(off models.ComputeOffering) int {
+n, _ := strconv.Atoi(strings.TrimSpace(off.NumberOfCores))
+return n
+}
+
+// offeringMemoryMB returns the memory of an offering in MB, 0 if unknown
+func offeringMemoryMB(off models.ComputeOffering) int {
+n, _ := strconv.Atoi(strings.TrimSpace(off.Memory))
+return n
+}
+
+// parseMemoryMB parses a memory size in MB, accepting M/MB/MiB and G/GB/GiB suffixes
+func parseMemoryMB(s string) (int, error) {
+s = strings.ToUpper(strings.TrimSpace(s))
+if s == "" {
+return 0, nil
+}
+
+multiplier := 1
+for _, suffix := range []string{"GIB", "GB", "G"} {
+if strings.HasSuffix(s, suffix) {
+s, multiplier = strings.TrimSuffix(s, suffix), 1024
+break
+}
+}
+if multiplier == 1 {
+for _, suffix := range []string{"MIB", "MB", "M"} {
+if strings.HasSuffix(s, suffix) {
+s = strings.TrimSuffix(s, suffix)
+break
+}
+}
+}
+
+// ParseFloat accepts NaN and Inf, which have no meaningful int conversion
+value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+if err != nil || value < 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+return 0, fmt.Errorf("expected a size such as 8192, 8G or 512M")
+}
+mb := value * float64(multiplier)
+if mb > math.MaxInt32 {
+return 0, fmt.Errorf("memory size is too large")
+}
+return int(mb), nil
+}
+
+// filterComputeOfferings keeps the offerings meeting the minimum CPU and memory
+func filterComputeOfferings(offerings []models.ComputeOffering, minCPU, minMemoryMB int, activeOnly bool) []models.ComputeOffering {
+var filtered []models.ComputeOffering
+for _, off := range offerings {
+if activeOnly && !off.IsActive {
+continue
+}
+if offeringCPU(off) < minCPU || offeringMemoryMB(off) < minMemoryMB {
+continue
+}
+filtered = append(filtered, off)
+}
+return filtered
+}
+
+// sortComputeOfferings orders offerings by name, cpu or memory; ties are broken
+// by the other dimension and then by name. An empty key keeps the API order.
+func sortComputeOfferings(offerings []models.ComputeOffering, by string) {
+if by == "" {
+return
+}
+
+sort.SliceStable(offerings, func(i, j int) bool {
+a, b := offerings[i], offerings[j]
+switch by {
+case "cpu":
+if offeringCPU(a) != offeringCPU(b) {
+return offeringCPU(a) < offeringCPU(b)
+}
+if offeringMemoryMB(a) != offeringMemoryMB(b) {
+return offeringMemoryMB(a) < offeringMemoryMB(b)
+}
+case "memory":
+if offeringMemoryMB(a) != offeringMemoryMB(b) {
+return offeringMemoryMB(a) < offeringMemoryMB(b)
+}
+if offeringCPU(a) != offeringCPU(b) {
+return offeringCPU(a) < offeringCPU(b)
+}
+}
+return a.Name < b.Name
+})
+}
+
+// recommendComputeOffering picks the smallest active offering with at least
+// the requested cores and memory, preferring fewer cores, then less memory
+func recommendComputeOffering(offerings []models.ComputeOffering, cpu, memoryMB int) *models.ComputeOffering {
+candidates := filterComputeOfferings(offerings, cpu, memoryMB, true)
+if len(candidates) == 0 {
+return nil
+}
+
+sortComputeOfferings(candidates, "cpu")
+return &candidates[0]
+}
+
 func init() {
 rootCmd.AddCommand(computeCmd)
 computeCmd.AddCommand(computeListCmd)
@@ -369,6 +934,9 @@ computeCmd.AddCommand(computeCreateCmd)
 computeCmd.AddCommand(computeStartCmd)
 computeCmd.AddCommand(computeStopCmd)
 computeCmd.AddCommand(computeDeleteCmd)
+computeCmd.AddCommand(computeSSHCmd)
+computeCmd.AddCommand(computeConsoleCmd)
+computeCmd.AddCommand(computeResetPasswordCmd)
 computeCmd.AddCommand(computeImagesCmd)
 computeCmd.AddCommand(computeSizesCmd)
 
@@ -378,4 +946,30 @@ computeCreateCmd.Flags().String("image", "", "Image/template UUID (required)")
 computeCreateCmd.Flags().String("size", "", "Compute offering UUID (required)")
 computeCreateCmd.Flags().String("network", "", "Network UUID (required)")
 computeCreateCmd.Flags().String("ssh-key", "", "SSH key name")
+computeCreateCmd.Flags().Int64("disk", 0, "Root disk size in GB (uses the image default if not specified)")
+computeCreateCmd.Flags().StringArray("tag", nil, "Tag as key=value (repeatable)")
+computeCreateCmd.Flags().Bool("estimate", false, "Print the estimated monthly cost before creating")
+
+computeSSHCmd.Flags().StringP("user", "l", "", "Login user (inferred from the image OS type by default)")
+computeSSHCmd.Flags().StringP("identity", "i", "", "Identity (private key) file")
+computeSSHCmd.Flags().StringP("jump", "J", "", "Jump host, as accepted by ssh -J")
+computeSSHCmd.Flags().IntP("port", "p", 22, "SSH port")
+computeSSHCmd.Flags().Bool("private", false, "Connect to the private IP instead of the public IP")
+
+computeConsoleCmd.Flags().Bool("print", false, "Print the console URL instead of opening a browser")
+
+computeListCmd.Flags().String("selector", "", "Filter by tags, e.g. env=prod,team=web")
+
+computeImagesCmd.Flags().String("os", "", "Only show images whose OS type or name contains this, e.g. ubuntu")
+computeImagesCmd.Flags().Bool("ready-only", false, "Only show images that are ready to use")
+
+computeSizesCmd.Flags().Int("min-cpu", 0, "Only show sizes with at least this many vCPUs")
+computeSizesCmd.Flags().String("min-memory", "", "Only show sizes with at least this much memory (e.g. 8192 or 8G)")
+computeSizesCmd.Flags().Bool("active-only", false, "Only show active sizes")
+computeSizesCmd.Flags().String("sort", "", "Sort by name, cpu or memory")
+computeSizesCmd.Flags().Bool("recommend", false, "Show only the smallest active size matching --cpu and --memory")
+computeSizesCmd.Flags().Int("cpu", 0, "vCPUs needed, with --recommend")
+computeSizesCmd.Flags().String("memory", "", "Memory needed, with --recommend (e.g. 8G)")
+
+computeDeleteCmd.Flags().Bool("expunge", false, "Expunge the instance immediately instead of allowing recovery")
 }
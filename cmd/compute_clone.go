@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// computeCloneCmd launches a copy of an instance
+var computeCloneCmd = &cobra.Command{
+	Use:   "clone <instance>",
+	Short: "Launch a copy of an instance",
+	Long: `Launch a copy of an instance with the same size and network.
+
+The root volume is snapshotted (the source keeps running), turned into an image,
+and a new instance is launched from that image. The image is kept so it can be
+reused; the intermediate snapshot is removed. If the launch fails, the image's
+UUID is printed so it can be deleted. Data volumes are not copied.`,
+	Example: `  sannti compute clone web-1 --name web-2`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		sshKey, _ := cmd.Flags().GetString("ssh-key")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		if name == "" {
+			return fmt.Errorf("required flag: --name")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		inst, err := c.FindInstance(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		offeringUUID := inst.ServiceOfferingUUID
+		if offeringUUID == "" {
+			offering, err := c.FindComputeOffering(inst.ServiceOfferingName, region)
+			if err != nil {
+				return fmt.Errorf("failed to resolve size of %s: %w", inst.Name, err)
+			}
+			offeringUUID = offering.UUID
+		}
+
+		networkUUID := inst.NetworkUUID
+		if networkUUID == "" {
+			network, err := c.FindNetwork(inst.NetworkName, region)
+			if err != nil {
+				return fmt.Errorf("failed to resolve network of %s: %w", inst.Name, err)
+			}
+			networkUUID = network.UUID
+		}
+
+		if sshKey != "" {
+			if _, err := c.GetSSHKey(sshKey, region); err != nil {
+				return err
+			}
+		}
+
+		volumes, err := c.ListInstanceVolumes(inst.UUID, region)
+		if err != nil {
+			return fmt.Errorf("failed to list volumes: %w", err)
+		}
+
+		var root *models.Volume
+		for i := range volumes {
+			if strings.EqualFold(volumes[i].Type, "ROOT") {
+				root = &volumes[i]
+				break
+			}
+		}
+		if root == nil {
+			return fmt.Errorf("instance %s has no root volume", inst.Name)
+		}
+
+		imageName := fmt.Sprintf("%s-%s", inst.Name, time.Now().UTC().Format("20060102-150405"))
+		deadline := time.Now().Add(timeout)
+
+		output.PrintInfo(fmt.Sprintf("Snapshotting root volume of %s...", inst.Name))
+		snap, err := c.CreateSnapshot(models.CreateSnapshotRequest{Name: imageName, VolumeUUID: root.UUID})
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot: %w", err)
+		}
+		defer func() {
+			if err := c.DeleteSnapshot(snap.UUID); err != nil {
+				output.PrintError(fmt.Sprintf("Could not remove intermediate snapshot %s (UUID: %s): %v", snap.Name, snap.UUID, err))
+			}
+		}()
+		if err := waitForSnapshot(c, snap.UUID, region, deadline); err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating image %s...", imageName))
+		tpl, err := c.CreateTemplate(models.CreateTemplateRequest{
+			Name:         imageName,
+			DisplayText:  fmt.Sprintf("Clone of %s", inst.Name),
+			SnapshotUUID: snap.UUID,
+			Region:       region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create image: %w", err)
+		}
+
+		// The image is only worth keeping if the clone was launched from it
+		launched := false
+		defer func() {
+			if !launched {
+				output.PrintError(fmt.Sprintf("Image %s (UUID: %s) was left behind; delete it with 'sannti image delete %s'", imageName, tpl.UUID, tpl.UUID))
+			}
+		}()
+		if err := waitForTemplate(c, tpl.UUID, region, deadline); err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Launching instance '%s'...", name))
+		clone, err := c.CreateInstance(models.CreateInstanceRequest{
+			Name:                name,
+			Region:              region,
+			TemplateUUID:        tpl.UUID,
+			ComputeOfferingUUID: offeringUUID,
+			NetworkUUID:         networkUUID,
+			SSHKeyName:          sshKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create instance: %w", err)
+		}
+		launched = true
+
+		output.PrintSuccess(fmt.Sprintf("Instance created: %s (UUID: %s) from image %s", clone.Name, clone.UUID, imageName))
+
+		if len(inst.Tags) > 0 {
+			if err := c.CreateTags("instance", clone.UUID, inst.Tags); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Copied tags %s", formatTags(inst.Tags)))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	computeCmd.AddCommand(computeCloneCmd)
+
+	computeCloneCmd.Flags().String("name", "", "Name of the new instance (required)")
+	computeCloneCmd.Flags().String("ssh-key", "", "SSH key name for the new instance")
+	computeCloneCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait for the image")
+}
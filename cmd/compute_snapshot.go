@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// computeSnapshotCmd groups the instance snapshot commands
+var computeSnapshotCmd = &cobra.Command{
+	Use:     "snapshot",
+	Aliases: []string{"snapshots", "snap"},
+	Short:   "Manage instance snapshots",
+	Long: `Capture the state of a whole instance (all of its disks, and optionally its
+memory) before an upgrade and roll back to it if something goes wrong.
+
+For snapshots of individual volumes see 'sannti snapshot'.`,
+}
+
+// computeSnapshotListCmd lists instance snapshots
+var computeSnapshotListCmd = &cobra.Command{
+	Use:   "list [instance]",
+	Short: "List instance snapshots",
+	Long:  `List instance snapshots in a region, optionally only those of one instance.`,
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snapshots, err := c.ListVMSnapshots(region)
+		if err != nil {
+			return fmt.Errorf("failed to list instance snapshots: %w", err)
+		}
+
+		if len(args) == 1 {
+			inst, err := c.FindInstance(args[0], region)
+			if err != nil {
+				return err
+			}
+			var filtered []models.VMSnapshot
+			for _, snap := range snapshots {
+				if snap.VirtualMachineUUID == inst.UUID {
+					filtered = append(filtered, snap)
+				}
+			}
+			snapshots = filtered
+		}
+
+		if len(snapshots) == 0 {
+			output.PrintInfo("No instance snapshots found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(snapshots))
+		for i, snap := range snapshots {
+			dataSlice[i] = snap
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "INSTANCE", "TYPE", "STATE", "CURRENT", "CREATED"},
+			func(item interface{}) []string {
+				snap := item.(models.VMSnapshot)
+				current := ""
+				if snap.Current {
+					current = "*"
+				}
+				return []string{
+					snap.UUID, snap.Name, valueOr(snap.VirtualMachineName, snap.VirtualMachineUUID),
+					valueOr(snap.Type, "-"), snap.State, current, valueOr(snap.Created, "-"),
+				}
+			},
+		)
+	},
+}
+
+// computeSnapshotCreateCmd snapshots an instance
+var computeSnapshotCreateCmd = &cobra.Command{
+	Use:   "create <instance>",
+	Short: "Snapshot an instance",
+	Long: `Snapshot all disks of an instance. With --memory the running memory state is
+captured too, so reverting resumes the instance exactly where it was.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		memory, _ := cmd.Flags().GetBool("memory")
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		inst, err := c.FindInstance(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating snapshot of instance %s...", inst.Name))
+
+		snap, err := c.CreateVMSnapshot(models.CreateVMSnapshotRequest{
+			VirtualMachineUUID: inst.UUID,
+			Name:               name,
+			Description:        description,
+			SnapshotMemory:     memory,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create instance snapshot: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Instance snapshot created: %s (UUID: %s)", valueOr(snap.Name, name), snap.UUID))
+		return nil
+	},
+}
+
+// computeSnapshotRevertCmd rolls an instance back to a snapshot
+var computeSnapshotRevertCmd = &cobra.Command{
+	Use:   "revert <snapshot>",
+	Short: "Revert an instance to a snapshot",
+	Long: `Roll an instance back to a snapshot. Everything written to its disks since the
+snapshot was taken is lost. Instances reverted to a disk-only snapshot are left
+stopped.
+
+You will be asked to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snap, err := c.FindVMSnapshot(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		instanceName := valueOr(snap.VirtualMachineName, snap.VirtualMachineUUID)
+		if err := confirmAction(fmt.Sprintf("Revert instance %s to snapshot %s? Changes made since %s will be lost.", instanceName, snap.Name, valueOr(snap.Created, "the snapshot"))); err != nil {
+			return err
+		}
+
+		if err := c.RevertVMSnapshot(snap.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Instance %s reverted to snapshot %s", instanceName, snap.Name))
+		return nil
+	},
+}
+
+// computeSnapshotDeleteCmd deletes an instance snapshot
+var computeSnapshotDeleteCmd = &cobra.Command{
+	Use:   "delete <snapshot>",
+	Short: "Delete an instance snapshot",
+	Long: `Delete an instance snapshot.
+
+You will be asked to type the snapshot name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snap, err := c.FindVMSnapshot(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructive("instance snapshot", snap.Name, []resourceDetail{
+			{"Name", snap.Name},
+			{"UUID", snap.UUID},
+			{"Instance", valueOr(snap.VirtualMachineName, snap.VirtualMachineUUID)},
+			{"Created", valueOr(snap.Created, "-")},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVMSnapshot(snap.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Instance snapshot %s deleted successfully", snap.Name))
+		return nil
+	},
+}
+
+func init() {
+	computeCmd.AddCommand(computeSnapshotCmd)
+	computeSnapshotCmd.AddCommand(computeSnapshotListCmd)
+	computeSnapshotCmd.AddCommand(computeSnapshotCreateCmd)
+	computeSnapshotCmd.AddCommand(computeSnapshotRevertCmd)
+	computeSnapshotCmd.AddCommand(computeSnapshotDeleteCmd)
+
+	computeSnapshotCreateCmd.Flags().String("name", "", "Snapshot name")
+	computeSnapshotCreateCmd.Flags().String("description", "", "Snapshot description")
+	computeSnapshotCreateCmd.Flags().Bool("memory", false, "Include the memory state (instance must be running)")
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+func TestParseMemoryMB(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"8192", 8192, false},
+		{"512M", 512, false},
+		{"512mb", 512, false},
+		{"512MiB", 512, false},
+		{"8G", 8192, false},
+		{" 8 GB ", 8192, false},
+		{"1.5GiB", 1536, false},
+		{"0", 0, false},
+		{"-1", 0, true},
+		{"8T", 0, true},
+		{"G", 0, true},
+		{"nan", 0, true},
+		{"NaN", 0, true},
+		{"inf", 0, true},
+		{"+Inf", 0, true},
+		{"infG", 0, true},
+		{"1e300", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseMemoryMB(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseMemoryMB(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseMemoryMB(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+// testOfferings is a small catalog of sizes in API order
+var testOfferings = []models.ComputeOffering{
+	{Name: "m2.large", NumberOfCores: "2", Memory: "16384", IsActive: true},
+	{Name: "c2.small", NumberOfCores: "1", Memory: "2048", IsActive: true},
+	{Name: "c2.medium", NumberOfCores: "2", Memory: "4096", IsActive: true},
+	{Name: "c2.large", NumberOfCores: "4", Memory: "8192", IsActive: true},
+	{Name: "c2.legacy", NumberOfCores: "2", Memory: "4096", IsActive: false},
+	{Name: "b1.tiny", NumberOfCores: "1", Memory: "1024", IsActive: true},
+}
+
+func offeringNames(offerings []models.ComputeOffering) []string {
+	var names []string
+	for _, off := range offerings {
+		names = append(names, off.Name)
+	}
+	return names
+}
+
+func TestSortComputeOfferings(t *testing.T) {
+	tests := []struct {
+		by   string
+		want []string
+	}{
+		{"", []string{"m2.large", "c2.small", "c2.medium", "c2.large", "c2.legacy", "b1.tiny"}},
+		{"name", []string{"b1.tiny", "c2.large", "c2.legacy", "c2.medium", "c2.small", "m2.large"}},
+		{"cpu", []string{"b1.tiny", "c2.small", "c2.legacy", "c2.medium", "m2.large", "c2.large"}},
+		{"memory", []string{"b1.tiny", "c2.small", "c2.legacy", "c2.medium", "c2.large", "m2.large"}},
+	}
+
+	for _, tt := range tests {
+		offerings := append([]models.ComputeOffering(nil), testOfferings...)
+		sortComputeOfferings(offerings, tt.by)
+		if got := offeringNames(offerings); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sortComputeOfferings(%q) = %v, want %v", tt.by, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendComputeOffering(t *testing.T) {
+	tests := []struct {
+		cpu, memoryMB int
+		want          string
+	}{
+		{0, 0, "b1.tiny"},
+		{1, 2048, "c2.small"},
+		{2, 0, "c2.medium"},
+		{2, 4096, "c2.medium"},
+		{2, 8192, "m2.large"},
+		{3, 0, "c2.large"},
+		{4, 16384, ""},
+		{8, 0, ""},
+	}
+
+	for _, tt := range tests {
+		best := recommendComputeOffering(testOfferings, tt.cpu, tt.memoryMB)
+		got := ""
+		if best != nil {
+			got = best.Name
+		}
+		if got != tt.want {
+			t.Errorf("recommendComputeOffering(%d, %d) = %q, want %q", tt.cpu, tt.memoryMB, got, tt.want)
+		}
+	}
+}
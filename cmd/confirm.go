@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// resourceDetail is a label/value pair shown before a destructive action
+type resourceDetail struct {
+	Label string
+	Value string
+}
+
+// confirmDestructive shows what is about to be destroyed and asks the user
+// to type the resource name to proceed. The prompt is skipped with --yes and
+// refused outright when stdin is not a terminal.
+func confirmDestructive(kind, name string, details []resourceDetail) error {
+	return confirmTyped(kind, "name", name, details)
+}
+
+// confirmDestructiveUUID is confirmDestructive for resources without a name,
+// asking for the UUID instead
+func confirmDestructiveUUID(kind, uuid string, details []resourceDetail) error {
+	return confirmTyped(kind, "UUID", uuid, details)
+}
+
+// confirmTyped asks the user to type the given field of a resource
+func confirmTyped(kind, field, name string, details []resourceDetail) error {
+	if assumeYes {
+		return nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return fmt.Errorf("refusing to delete %s '%s' without confirmation: stdin is not a terminal (use --yes to skip the prompt)", kind, name)
+	}
+
+	fmt.Printf("The following %s will be permanently deleted:\n\n", kind)
+	for _, d := range details {
+		value := d.Value
+		if value == "" {
+			value = "-"
+		}
+		fmt.Printf("  %-12s %s\n", d.Label+":", value)
+	}
+	fmt.Println()
+
+	fmt.Printf("Type the %s %s '%s' to confirm: ", kind, field, name)
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+
+	if strings.TrimSpace(answer) != name {
+		return fmt.Errorf("confirmation did not match, %s '%s' was not deleted", kind, name)
+	}
+
+	return nil
+}
+
+// confirmAction asks a yes/no question before a change proceeds. Like
+// confirmDestructive it is skipped with --yes and refused without a terminal.
+func confirmAction(question string) error {
+	if assumeYes {
+		return nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return fmt.Errorf("refusing to continue without confirmation: stdin is not a terminal (use --yes to skip the prompt)")
+	}
+
+	fmt.Printf("%s [y/N]: ", question)
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return fmt.Errorf("aborted")
+	}
+}
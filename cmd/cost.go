@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// hoursPerMonth is the billing month used for monthly prices
+const hoursPerMonth = 730
+
+// costCmd represents the cost command
+var costCmd = &cobra.Command{
+	Use:   "cost",
+	Short: "Estimate resource costs",
+	Long:  `Estimate what instances and Kubernetes clusters will cost before creating them.`,
+}
+
+// costEstimateCmd groups the cost estimate commands
+var costEstimateCmd = &cobra.Command{
+	Use:   "estimate",
+	Short: "Estimate the cost of instances or a Kubernetes cluster",
+	Long: `Estimate the cost of instances or a Kubernetes cluster. Prices are for
+--hours of usage (a 730-hour month by default).`,
+}
+
+// costEstimateInstanceCmd prices instances
+var costEstimateInstanceCmd = &cobra.Command{
+	Use:     "instance",
+	Aliases: []string{"compute", "instances"},
+	Short:   "Estimate the cost of instances",
+	Example: `  sannti cost estimate instance --size c2.medium --count 3
+  sannti cost estimate instance --size c2.medium --disk 100 --hours 24`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		sizeRef, _ := cmd.Flags().GetString("size")
+		disk, _ := cmd.Flags().GetInt64("disk")
+		count, _ := cmd.Flags().GetInt("count")
+		hours, _ := cmd.Flags().GetInt("hours")
+
+		if sizeRef == "" {
+			return fmt.Errorf("required flag: --size")
+		}
+		if err := validateEstimateFlags("--count", count, hours, disk); err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		offering, err := c.FindComputeOffering(sizeRef, region)
+		if err != nil {
+			return err
+		}
+
+		estimate, err := c.EstimateInstanceCost(models.CostEstimateRequest{
+			ComputeOfferingUUID: offering.UUID,
+			DiskSize:            disk,
+			Count:               count,
+			Hours:               hours,
+			Region:              region,
+		})
+		if err != nil {
+			return err
+		}
+
+		return printCostEstimate(estimate)
+	},
+}
+
+// costEstimateK8sCmd prices a Kubernetes cluster
+var costEstimateK8sCmd = &cobra.Command{
+	Use:     "k8s",
+	Aliases: []string{"kubernetes", "cluster"},
+	Short:   "Estimate the cost of a Kubernetes cluster",
+	Example: `  sannti cost estimate k8s --node-size c2.large --size 3 --ha`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		sizeRef, _ := cmd.Flags().GetString("node-size")
+		versionRef, _ := cmd.Flags().GetString("version")
+		nodes, _ := cmd.Flags().GetInt("size")
+		controlNodes, _ := cmd.Flags().GetInt("control-nodes")
+		haEnabled, _ := cmd.Flags().GetBool("ha")
+		nodeDisk, _ := cmd.Flags().GetInt64("node-disk")
+		hours, _ := cmd.Flags().GetInt("hours")
+
+		if sizeRef == "" {
+			return fmt.Errorf("required flag: --node-size")
+		}
+		if haEnabled && !cmd.Flags().Changed("control-nodes") {
+			controlNodes = 3
+		}
+		if controlNodes < 1 {
+			return fmt.Errorf("--control-nodes must be at least 1")
+		}
+		if err := validateEstimateFlags("--size", nodes, hours, nodeDisk); err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		offering, err := c.FindComputeOffering(sizeRef, region)
+		if err != nil {
+			return err
+		}
+
+		req := models.CostEstimateRequest{
+			ComputeOfferingUUID: offering.UUID,
+			DiskSize:            nodeDisk,
+			Count:               nodes,
+			ControlNodes:        controlNodes,
+			Hours:               hours,
+			Region:              region,
+		}
+
+		if versionRef != "" {
+			version, err := c.FindKubernetesVersion(versionRef, region)
+			if err != nil {
+				return err
+			}
+			req.KubernetesVersionUUID = version.UUID
+		}
+
+		estimate, err := c.EstimateKubernetesCost(req)
+		if err != nil {
+			return err
+		}
+
+		return printCostEstimate(estimate)
+	},
+}
+
+// validateEstimateFlags checks the count, hours and disk flags shared by the estimate commands
+func validateEstimateFlags(countFlag string, count, hours int, disk int64) error {
+	if count < 1 {
+		return fmt.Errorf("%s must be at least 1", countFlag)
+	}
+	if hours < 1 {
+		return fmt.Errorf("--hours must be at least 1")
+	}
+	if disk < 0 {
+		return fmt.Errorf("disk size cannot be negative")
+	}
+	return nil
+}
+
+// printCostEstimate prints the line items of an estimate followed by its totals
+func printCostEstimate(estimate *models.CostEstimate) error {
+	if output.Format(outputFormat) != output.FormatTable {
+		return output.Print(estimate, output.Format(outputFormat), nil, nil)
+	}
+
+	if len(estimate.Items) > 0 {
+		dataSlice := make([]interface{}, len(estimate.Items))
+		for i, item := range estimate.Items {
+			dataSlice[i] = item
+		}
+
+		if err := output.Print(
+			dataSlice,
+			output.FormatTable,
+			[]string{"ITEM", "QTY", "PER HOUR", fmt.Sprintf("TOTAL (%dH)", estimate.Hours)},
+			func(item interface{}) []string {
+				line := item.(models.CostEstimateItem)
+				return []string{
+					line.Description, strconv.Itoa(line.Quantity),
+					formatPrice(line.HourlyCost, estimate.Currency), formatPrice(line.TotalCost, estimate.Currency),
+				}
+			},
+		); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("Hourly:  %s\n", formatPrice(estimate.HourlyCost, estimate.Currency))
+	fmt.Printf("Monthly: %s\n", formatPrice(estimate.MonthlyCost, estimate.Currency))
+	if estimate.Hours != hoursPerMonth {
+		fmt.Printf("Total for %d hours: %s\n", estimate.Hours, formatPrice(estimate.TotalCost, estimate.Currency))
+	}
+	return nil
+}
+
+// printEstimateSummary prints the monthly cost of a resource about to be created
+func printEstimateSummary(estimate *models.CostEstimate, what string) {
+	output.PrintInfo(fmt.Sprintf("Estimated cost of %s: %s per month (%s per hour)",
+		what, formatPrice(estimate.MonthlyCost, estimate.Currency), formatPrice(estimate.HourlyCost, estimate.Currency)))
+}
+
+// formatPrice renders an amount with its currency code
+func formatPrice(amount float64, currency string) string {
+	if currency == "" {
+		return fmt.Sprintf("%.2f", amount)
+	}
+	return fmt.Sprintf("%s %.2f", currency, amount)
+}
+
+func init() {
+	rootCmd.AddCommand(costCmd)
+	costCmd.AddCommand(costEstimateCmd)
+	costEstimateCmd.AddCommand(costEstimateInstanceCmd)
+	costEstimateCmd.AddCommand(costEstimateK8sCmd)
+
+	costEstimateInstanceCmd.Flags().String("size", "", "Compute size name or UUID (required)")
+	costEstimateInstanceCmd.Flags().Int64("disk", 0, "Root disk size in GB (uses the image default if not specified)")
+	costEstimateInstanceCmd.Flags().Int("count", 1, "Number of instances")
+	costEstimateInstanceCmd.Flags().Int("hours", hoursPerMonth, "Hours of usage to price")
+
+	costEstimateK8sCmd.Flags().String("node-size", "", "Compute size name or UUID for the nodes (required)")
+	costEstimateK8sCmd.Flags().String("version", "", "Kubernetes version name or UUID")
+	costEstimateK8sCmd.Flags().Int("size", 1, "Number of worker nodes")
+	costEstimateK8sCmd.Flags().Int("control-nodes", 1, "Number of control plane nodes (3 by default with --ha)")
+	costEstimateK8sCmd.Flags().Bool("ha", false, "Price a highly available control plane")
+	costEstimateK8sCmd.Flags().Int64("node-disk", 0, "Node root disk size in GB (uses the image default if not specified)")
+	costEstimateK8sCmd.Flags().Int("hours", hoursPerMonth, "Hours of usage to price")
+}
@@ -2,12 +2,18 @@ package cmd
 
 import (
 	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/sannticloud/sannti-cli/internal/client"
 	"github.com/sannticloud/sannti-cli/internal/config"
 	"github.com/sannticloud/sannti-cli/internal/models"
 	"github.com/sannticloud/sannti-cli/internal/output"
+	"gopkg.in/yaml.v3"
 )
 
 // firewallCmd represents the firewall command
@@ -21,7 +27,7 @@ var firewallCmd = &cobra.Command{
 var firewallListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List firewall rules",
-	Long:  `List all firewall rules.`,
+	Long:  `List all firewall rules, optionally filtered by the public IP they apply to.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -29,6 +35,7 @@ var firewallListCmd = &cobra.Command{
 		}
 
 		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		ipRef, _ := cmd.Flags().GetString("ip")
 
 		region := regionFlag
 		if region == "" {
@@ -40,6 +47,21 @@ var firewallListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list firewall rules: %w", err)
 		}
 
+		if ipRef != "" {
+			ip, err := c.FindIPAddress(ipRef, region)
+			if err != nil {
+				return err
+			}
+
+			var matched []models.FirewallRule
+			for _, rule := range rules {
+				if rule.IPAddressUUID == ip.UUID || rule.IPAddress == ip.IpAddress {
+					matched = append(matched, rule)
+				}
+			}
+			rules = matched
+		}
+
 		if len(rules) == 0 {
 			output.PrintInfo("No firewall rules found")
 			return nil
@@ -54,20 +76,653 @@ var firewallListCmd = &cobra.Command{
 		return output.Print(
 			dataSlice,
 			output.Format(outputFormat),
-			[]string{"UUID", "PROTOCOL", "PORT RANGE", "CIDR", "STATE"},
+			[]string{"UUID", "IP ADDRESS", "PROTOCOL", "PORT RANGE", "CIDR", "STATE"},
 			func(item interface{}) []string {
 				rule := item.(models.FirewallRule)
-				portRange := fmt.Sprintf("%s-%s", rule.StartPort, rule.EndPort)
-				if rule.StartPort == rule.EndPort {
-					portRange = rule.StartPort
+				ip := rule.IPAddress
+				if ip == "" {
+					ip = "-"
 				}
-				return []string{rule.UUID, rule.Protocol, portRange, rule.CidrList, rule.State}
+				return []string{rule.UUID, ip, rule.Protocol, formatPortRange(rule), rule.CidrList, rule.State}
 			},
 		)
 	},
 }
 
+// firewallCreateCmd creates firewall rules
+var firewallCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create firewall rules",
+	Long: `Create firewall rules allowing traffic to a public IP address.
+
+One rule is created per --port. Ports are given as a single port (22) or a
+range (8000-8100). ICMP rules take --icmp-type and --icmp-code instead of
+ports, where -1 matches any type or code.
+
+Examples:
+  sannti firewall create --ip 203.0.113.10 --protocol tcp --port 22 --cidr 10.0.0.0/8
+  sannti firewall create --ip 203.0.113.10 --protocol tcp --port 80 --port 443 --cidr 0.0.0.0/0
+  sannti firewall create --ip 203.0.113.10 --protocol icmp --icmp-type 8 --cidr 10.0.0.0/8`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		ipRef, _ := cmd.Flags().GetString("ip")
+		protocol, _ := cmd.Flags().GetString("protocol")
+		ports, _ := cmd.Flags().GetStringArray("port")
+		cidrs, _ := cmd.Flags().GetStringSlice("cidr")
+		icmpType, _ := cmd.Flags().GetInt("icmp-type")
+		icmpCode, _ := cmd.Flags().GetInt("icmp-code")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if ipRef == "" || protocol == "" {
+			return fmt.Errorf("required flags: --ip, --protocol")
+		}
+
+		if (cmd.Flags().Changed("icmp-type") || cmd.Flags().Changed("icmp-code")) && strings.ToLower(protocol) != "icmp" {
+			return fmt.Errorf("--icmp-type and --icmp-code are only valid with --protocol icmp")
+		}
+
+		specs, err := newFirewallRuleSpecs(protocol, ports, cidrs, icmpType, icmpCode)
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		ip, err := c.FindIPAddress(ipRef, region)
+		if err != nil {
+			return err
+		}
+
+		for _, spec := range specs {
+			output.PrintInfo(fmt.Sprintf("Creating firewall rule %s on %s...", spec, ip.IpAddress))
+
+			rule, err := c.CreateFirewallRule(spec.request(ip.UUID))
+			if err != nil {
+				return fmt.Errorf("failed to create firewall rule %s: %w", spec, err)
+			}
+
+			output.PrintSuccess(fmt.Sprintf("Firewall rule created (UUID: %s)", rule.UUID))
+		}
+
+		return nil
+	},
+}
+
+// firewallDeleteCmd deletes firewall rules
+var firewallDeleteCmd = &cobra.Command{
+	Use:   "delete <uuid>...",
+	Short: "Delete firewall rules",
+	Long: `Delete one or more firewall rules by UUID.
+
+You will be asked to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		var rules []*models.FirewallRule
+		for _, uuid := range args {
+			rule, err := c.FindFirewallRule(uuid, region)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, rule)
+		}
+
+		fmt.Println("The following firewall rules will be deleted:")
+		fmt.Println()
+		for _, rule := range rules {
+			fmt.Printf("  %s  %s %s from %s on %s\n", rule.UUID, rule.Protocol, formatPortRange(*rule), rule.CidrList, rule.IPAddress)
+		}
+		fmt.Println()
+
+		if err := confirmAction(fmt.Sprintf("Delete %d firewall rule(s)?", len(rules))); err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if err := c.DeleteFirewallRule(rule.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Firewall rule %s deleted successfully", rule.UUID))
+		}
+
+		return nil
+	},
+}
+
+// firewallApplyCmd syncs firewall rules with a file
+var firewallApplyCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Sync firewall rules from a file",
+	Long: `Compare the firewall rules in a YAML file with the rules in Sannti Cloud,
+print a plan of rules to add and remove, and apply it after confirmation.
+
+Only the public IPs listed in the file are considered. Rules on those IPs
+that are not in the file are removed only with --prune.
+
+File format:
+  rules:
+    - ip: 203.0.113.10
+      protocol: tcp
+      ports: ["22", "8000-8100"]
+      cidrs: [10.0.0.0/8]
+    - ip: 203.0.113.10
+      protocol: icmp
+      icmpType: 8
+      cidrs: [10.0.0.0/8]`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		file, _ := cmd.Flags().GetString("file")
+		prune, _ := cmd.Flags().GetBool("prune")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if file == "" {
+			return fmt.Errorf("required flag: --file")
+		}
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		ruleFile, err := loadFirewallRuleFile(file)
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		ips, err := c.ListIPAddresses(region)
+		if err != nil {
+			return fmt.Errorf("failed to list IP addresses: %w", err)
+		}
+
+		existing, err := c.ListFirewallRules(region)
+		if err != nil {
+			return fmt.Errorf("failed to list firewall rules: %w", err)
+		}
+
+		plan, err := planFirewallSync(ruleFile, ips, existing, prune)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range plan.Invalid {
+			output.PrintError(fmt.Sprintf("Warning: existing rule %s could not be parsed: %v", r.Rule.UUID, r.Invalid))
+		}
+
+		if len(plan.Add) == 0 && len(plan.Remove) == 0 {
+			output.PrintSuccess("Firewall rules are up to date")
+			return nil
+		}
+
+		fmt.Println("Firewall plan:")
+		fmt.Println()
+		for _, a := range plan.Add {
+			fmt.Printf("  + %s on %s\n", a.Spec, a.IP.IpAddress)
+		}
+		for _, r := range plan.Remove {
+			fmt.Printf("  - %s on %s (%s)\n", r, r.Rule.IPAddress, r.Rule.UUID)
+		}
+		fmt.Println()
+		fmt.Printf("%d to add, %d to remove, %d unchanged.\n", len(plan.Add), len(plan.Remove), plan.Unchanged)
+		if !prune && plan.Unmanaged > 0 {
+			fmt.Printf("%d rule(s) not in the file were left in place (use --prune to remove them).\n", plan.Unmanaged)
+		}
+		fmt.Println()
+
+		if dryRun {
+			return nil
+		}
+
+		if err := confirmAction("Apply these changes?"); err != nil {
+			return err
+		}
+
+		// Add before removing so replaced rules never leave a gap in access
+		for _, a := range plan.Add {
+			rule, err := c.CreateFirewallRule(a.Spec.request(a.IP.UUID))
+			if err != nil {
+				return fmt.Errorf("failed to create firewall rule %s: %w", a.Spec, err)
+			}
+			output.PrintSuccess(fmt.Sprintf("Added %s on %s (UUID: %s)", a.Spec, a.IP.IpAddress, rule.UUID))
+		}
+
+		for _, r := range plan.Remove {
+			if err := c.DeleteFirewallRule(r.Rule.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Removed %s on %s", r, r.Rule.IPAddress))
+		}
+
+		return nil
+	},
+}
+
+// firewallRuleFile is the file format read by 'firewall apply'
+type firewallRuleFile struct {
+	Rules []struct {
+		IP       string   `yaml:"ip"`
+		Protocol string   `yaml:"protocol"`
+		Ports    []string `yaml:"ports"`
+		Cidrs    []string `yaml:"cidrs"`
+		IcmpType *int     `yaml:"icmpType"`
+		IcmpCode *int     `yaml:"icmpCode"`
+	} `yaml:"rules"`
+}
+
+// loadFirewallRuleFile reads and decodes a firewall rule file
+func loadFirewallRuleFile(path string) (*firewallRuleFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+
+	var ruleFile firewallRuleFile
+	if err := yaml.Unmarshal(data, &ruleFile); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file %s: %w", path, err)
+	}
+
+	return &ruleFile, nil
+}
+
+// firewallPlan is the set of changes needed to match a rule file. Invalid
+// lists existing rules that could not be parsed; they are also in Remove
+// when pruning and counted as Unmanaged otherwise.
+type firewallPlan struct {
+	Add       []plannedRule
+	Remove    []staleRule
+	Invalid   []staleRule
+	Unchanged int
+	Unmanaged int
+}
+
+// plannedRule is a desired rule that does not exist yet
+type plannedRule struct {
+	IP   models.IPAddress
+	Spec firewallRuleSpec
+}
+
+// staleRule is an existing rule that is not in the file. Invalid is set when
+// the rule could not be parsed, in which case Spec is empty.
+type staleRule struct {
+	Rule    models.FirewallRule
+	Spec    firewallRuleSpec
+	Invalid error
+}
+
+// String describes the rule, falling back to its raw fields if it did not parse
+func (r staleRule) String() string {
+	if r.Invalid != nil {
+		return fmt.Sprintf("unparsable rule %s %s from '%s'", r.Rule.Protocol, valueOr(r.Rule.StartPort, "-"), r.Rule.CidrList)
+	}
+	return r.Spec.String()
+}
+
+// planFirewallSync compares the desired rules with the existing ones on the
+// IPs named in the file. Unlisted rules are removed only when prune is set.
+func planFirewallSync(ruleFile *firewallRuleFile, ips []models.IPAddress, existing []models.FirewallRule, prune bool) (*firewallPlan, error) {
+	plan := &firewallPlan{}
+
+	managed := make(map[string]models.IPAddress)
+	desired := make(map[string]bool)
+	for i, r := range ruleFile.Rules {
+		var ip *models.IPAddress
+		for j := range ips {
+			if ips[j].IpAddress == r.IP || ips[j].UUID == r.IP {
+				ip = &ips[j]
+				break
+			}
+		}
+		if ip == nil {
+			return nil, fmt.Errorf("rule %d: IP address not found: %s", i+1, r.IP)
+		}
+		managed[ip.UUID] = *ip
+
+		icmpType, icmpCode := -1, -1
+		if r.IcmpType != nil {
+			icmpType = *r.IcmpType
+		}
+		if r.IcmpCode != nil {
+			icmpCode = *r.IcmpCode
+		}
+		if (r.IcmpType != nil || r.IcmpCode != nil) && strings.ToLower(r.Protocol) != "icmp" {
+			return nil, fmt.Errorf("rule %d: icmpType and icmpCode are only valid for icmp rules", i+1)
+		}
+
+		specs, err := newFirewallRuleSpecs(r.Protocol, r.Ports, r.Cidrs, icmpType, icmpCode)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+
+		for _, spec := range specs {
+			key := ip.UUID + " " + spec.key()
+			if desired[key] {
+				continue
+			}
+			desired[key] = true
+			plan.Add = append(plan.Add, plannedRule{IP: *ip, Spec: spec})
+		}
+	}
+
+	present := make(map[string]bool)
+	for _, rule := range existing {
+		ipUUID := rule.IPAddressUUID
+		if ipUUID == "" {
+			for _, ip := range managed {
+				if ip.IpAddress == rule.IPAddress {
+					ipUUID = ip.UUID
+				}
+			}
+		}
+		if _, ok := managed[ipUUID]; !ok {
+			continue
+		}
+
+		spec, err := firewallRuleSpecFromRule(rule)
+		if err != nil {
+			// A rule that cannot be parsed never matches the file, so treat it
+			// like any other rule that is not listed there
+			stale := staleRule{Rule: rule, Invalid: err}
+			plan.Invalid = append(plan.Invalid, stale)
+			if prune {
+				plan.Remove = append(plan.Remove, stale)
+			} else {
+				plan.Unmanaged++
+			}
+			continue
+		}
+
+		key := ipUUID + " " + spec.key()
+		switch {
+		case desired[key] && !present[key]:
+			present[key] = true
+			plan.Unchanged++
+		case prune:
+			plan.Remove = append(plan.Remove, staleRule{Rule: rule, Spec: spec})
+		default:
+			plan.Unmanaged++
+		}
+	}
+
+	// Drop rules that already exist from the list to add
+	toAdd := plan.Add[:0]
+	for _, a := range plan.Add {
+		if !present[a.IP.UUID+" "+a.Spec.key()] {
+			toAdd = append(toAdd, a)
+		}
+	}
+	plan.Add = toAdd
+
+	return plan, nil
+}
+
+// firewallRuleSpecFromRule converts an existing rule to a comparable spec
+func firewallRuleSpecFromRule(rule models.FirewallRule) (firewallRuleSpec, error) {
+	spec := firewallRuleSpec{Protocol: strings.ToLower(rule.Protocol), IcmpType: -1, IcmpCode: -1}
+
+	cidrs, err := normalizeCIDRs([]string{rule.CidrList})
+	if err != nil {
+		return spec, err
+	}
+	spec.Cidrs = cidrs
+
+	if spec.Protocol == "icmp" {
+		if rule.IcmpType != "" {
+			if spec.IcmpType, err = strconv.Atoi(rule.IcmpType); err != nil {
+				return spec, fmt.Errorf("invalid ICMP type '%s'", rule.IcmpType)
+			}
+		}
+		if rule.IcmpCode != "" {
+			if spec.IcmpCode, err = strconv.Atoi(rule.IcmpCode); err != nil {
+				return spec, fmt.Errorf("invalid ICMP code '%s'", rule.IcmpCode)
+			}
+		}
+		return spec, nil
+	}
+
+	if rule.StartPort != "" {
+		end := rule.EndPort
+		if end == "" {
+			end = rule.StartPort
+		}
+		if spec.StartPort, spec.EndPort, err = parsePortRange(rule.StartPort + "-" + end); err != nil {
+			return spec, err
+		}
+	}
+
+	return spec, nil
+}
+
+// key identifies a rule by everything except its UUID
+func (s firewallRuleSpec) key() string {
+	return fmt.Sprintf("%s %d-%d %d/%d %s", s.Protocol, s.StartPort, s.EndPort, s.IcmpType, s.IcmpCode, strings.Join(s.Cidrs, ","))
+}
+
+// firewallProtocols are the protocols accepted by firewall rules
+var firewallProtocols = []string{"tcp", "udp", "icmp", "all"}
+
+// firewallRuleSpec is a validated firewall rule, independent of its UUID
+type firewallRuleSpec struct {
+	Protocol  string
+	StartPort int
+	EndPort   int
+	Cidrs     []string
+	IcmpType  int
+	IcmpCode  int
+}
+
+// newFirewallRuleSpecs validates the protocol, ports, CIDRs and ICMP settings
+// of a rule and returns one spec per port range
+func newFirewallRuleSpecs(protocol string, ports, cidrs []string, icmpType, icmpCode int) ([]firewallRuleSpec, error) {
+	protocol = strings.ToLower(protocol)
+
+	valid := false
+	for _, p := range firewallProtocols {
+		if protocol == p {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid protocol '%s': must be one of %s", protocol, strings.Join(firewallProtocols, ", "))
+	}
+
+	normalized, err := normalizeCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+
+	base := firewallRuleSpec{Protocol: protocol, Cidrs: normalized, IcmpType: -1, IcmpCode: -1}
+
+	switch protocol {
+	case "icmp":
+		if len(ports) > 0 {
+			return nil, fmt.Errorf("--port is not valid for icmp rules, use --icmp-type and --icmp-code")
+		}
+		if icmpType < -1 || icmpType > 255 {
+			return nil, fmt.Errorf("invalid ICMP type %d: must be between 0 and 255, or -1 for any", icmpType)
+		}
+		if icmpCode < -1 || icmpCode > 255 {
+			return nil, fmt.Errorf("invalid ICMP code %d: must be between 0 and 255, or -1 for any", icmpCode)
+		}
+		base.IcmpType, base.IcmpCode = icmpType, icmpCode
+		return []firewallRuleSpec{base}, nil
+
+	case "all":
+		if len(ports) > 0 {
+			return nil, fmt.Errorf("--port is not valid for protocol 'all'")
+		}
+		return []firewallRuleSpec{base}, nil
+	}
+
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("at least one --port is required for %s rules", protocol)
+	}
+
+	specs := make([]firewallRuleSpec, 0, len(ports))
+	for _, p := range ports {
+		start, end, err := parsePortRange(p)
+		if err != nil {
+			return nil, err
+		}
+		spec := base
+		spec.StartPort, spec.EndPort = start, end
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// request builds the API request that creates the rule on a public IP
+func (s firewallRuleSpec) request(ipAddressUUID string) models.CreateFirewallRuleRequest {
+	req := models.CreateFirewallRuleRequest{
+		IPAddressUUID: ipAddressUUID,
+		Protocol:      s.Protocol,
+		StartPort:     s.StartPort,
+		EndPort:       s.EndPort,
+		CidrList:      strings.Join(s.Cidrs, ","),
+	}
+	if s.Protocol == "icmp" {
+		icmpType, icmpCode := s.IcmpType, s.IcmpCode
+		req.IcmpType, req.IcmpCode = &icmpType, &icmpCode
+	}
+	return req
+}
+
+// String renders the spec for progress messages, e.g. "tcp/22 from 10.0.0.0/8"
+func (s firewallRuleSpec) String() string {
+	var target string
+	switch {
+	case s.Protocol == "icmp":
+		target = fmt.Sprintf("icmp type %d code %d", s.IcmpType, s.IcmpCode)
+	case s.Protocol == "all":
+		target = "all"
+	case s.StartPort == s.EndPort:
+		target = fmt.Sprintf("%s/%d", s.Protocol, s.StartPort)
+	default:
+		target = fmt.Sprintf("%s/%d-%d", s.Protocol, s.StartPort, s.EndPort)
+	}
+	return fmt.Sprintf("%s from %s", target, strings.Join(s.Cidrs, ","))
+}
+
+// parsePortRange parses a single port (22) or a range (8000-8100)
+func parsePortRange(spec string) (int, int, error) {
+	startStr, endStr, isRange := strings.Cut(strings.TrimSpace(spec), "-")
+	if !isRange {
+		endStr = startStr
+	}
+
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port '%s': expected a port or a range like 8000-8100", spec)
+	}
+	end, err := strconv.Atoi(endStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port '%s': expected a port or a range like 8000-8100", spec)
+	}
+
+	if start < 1 || start > 65535 || end < 1 || end > 65535 {
+		return 0, 0, fmt.Errorf("invalid port '%s': ports must be between 1 and 65535", spec)
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("invalid port range '%s': start is greater than end", spec)
+	}
+
+	return start, end, nil
+}
+
+// normalizeCIDRs validates a list of CIDRs and returns them in canonical,
+// sorted form. Entries may also be comma-separated.
+func normalizeCIDRs(cidrs []string) ([]string, error) {
+	var normalized []string
+	for _, entry := range cidrs {
+		for _, cidr := range strings.Split(entry, ",") {
+			cidr = strings.TrimSpace(cidr)
+			if cidr == "" {
+				continue
+			}
+
+			ip, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+			}
+			if !ip.Equal(ipNet.IP) {
+				return nil, fmt.Errorf("invalid CIDR '%s': host bits are set, did you mean %s?", cidr, ipNet)
+			}
+			normalized = append(normalized, ipNet.String())
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil, fmt.Errorf("at least one --cidr is required (use 0.0.0.0/0 to allow any source)")
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// formatPortRange renders the ports of a rule for table output
+func formatPortRange(rule models.FirewallRule) string {
+	if strings.EqualFold(rule.Protocol, "icmp") {
+		return fmt.Sprintf("type %s code %s", valueOr(rule.IcmpType, "-1"), valueOr(rule.IcmpCode, "-1"))
+	}
+	if rule.StartPort == "" {
+		return "-"
+	}
+	if rule.StartPort == rule.EndPort || rule.EndPort == "" {
+		return rule.StartPort
+	}
+	return fmt.Sprintf("%s-%s", rule.StartPort, rule.EndPort)
+}
+
+// valueOr returns value, or fallback if value is empty
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
 func init() {
 	rootCmd.AddCommand(firewallCmd)
 	firewallCmd.AddCommand(firewallListCmd)
+	firewallCmd.AddCommand(firewallCreateCmd)
+	firewallCmd.AddCommand(firewallDeleteCmd)
+	firewallCmd.AddCommand(firewallApplyCmd)
+
+	firewallListCmd.Flags().String("ip", "", "Only show rules for this public IP address or IP UUID")
+
+	firewallCreateCmd.Flags().String("ip", "", "Public IP address or IP UUID the rules apply to (required)")
+	firewallCreateCmd.Flags().String("protocol", "", "Protocol: tcp, udp, icmp or all (required)")
+	firewallCreateCmd.Flags().StringArray("port", nil, "Port or port range, e.g. 22 or 8000-8100 (repeatable)")
+	firewallCreateCmd.Flags().StringSlice("cidr", nil, "Allowed source CIDR (repeatable or comma-separated)")
+	firewallCreateCmd.Flags().Int("icmp-type", -1, "ICMP type, -1 for any")
+	firewallCreateCmd.Flags().Int("icmp-code", -1, "ICMP code, -1 for any")
+
+	firewallApplyCmd.Flags().StringP("file", "f", "", "YAML file with the desired rules (required)")
+	firewallApplyCmd.Flags().Bool("prune", false, "Remove rules on the listed IPs that are not in the file")
+	firewallApplyCmd.Flags().Bool("dry-run", false, "Only print the plan")
 }
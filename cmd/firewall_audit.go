@@ -0,0 +1,396 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+	"gopkg.in/yaml.v3"
+)
+
+// firewallAuditCmd flags risky firewall rules
+var firewallAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Audit firewall rules for risky configurations",
+	Long: `Scan firewall rules and flag risky configurations:
+
+  world-open-admin-port  admin ports (SSH, RDP, databases...) open to 0.0.0.0/0
+  world-open-all         all protocols open to 0.0.0.0/0
+  large-port-range       port ranges wider than the policy allows
+  duplicate-rule         identical rules on the same IP
+  shadowed-rule          rules fully covered by a broader rule on the same IP
+  released-ip            rules on IPs that are no longer allocated
+  unparsable-rule        rules with a CIDR list, port or ICMP value that cannot be read
+
+All active regions are scanned unless --region is given. Checks can be tuned
+with a YAML policy file (--policy):
+
+  adminPorts: [22, 3389, 5432]
+  maxPortRange: 1000
+  allowWorldOpen: ["tcp/80", "tcp/443"]
+  severities:
+    large-port-range: high
+  failOn: medium
+
+The command exits non-zero when any finding is at or above the --fail-on
+severity, so it can gate CI pipelines.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		policyFile, _ := cmd.Flags().GetString("policy")
+		failOn, _ := cmd.Flags().GetString("fail-on")
+
+		policy := defaultAuditPolicy()
+		if policyFile != "" {
+			if policy, err = loadAuditPolicy(policyFile); err != nil {
+				return err
+			}
+		}
+		if cmd.Flags().Changed("fail-on") {
+			policy.FailOn = failOn
+		}
+		threshold, ok := severityRank[policy.FailOn]
+		if !ok {
+			return fmt.Errorf("invalid fail-on severity '%s': must be one of low, medium, high, critical", policy.FailOn)
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		regions := []string{regionFlag}
+		if regionFlag == "" {
+			zones, err := c.ListZones()
+			if err != nil {
+				return fmt.Errorf("failed to list regions: %w", err)
+			}
+			regions = regions[:0]
+			for _, zone := range zones {
+				if zone.IsActive {
+					regions = append(regions, zone.Name)
+				}
+			}
+		}
+
+		var findings []auditFinding
+		for _, region := range regions {
+			rules, err := c.ListFirewallRules(region)
+			if err != nil {
+				return fmt.Errorf("failed to list firewall rules in %s: %w", region, err)
+			}
+
+			ips, err := c.ListIPAddresses(region)
+			if err != nil {
+				return fmt.Errorf("failed to list IP addresses in %s: %w", region, err)
+			}
+
+			findings = append(findings, auditFirewallRules(region, rules, ips, policy)...)
+		}
+
+		if len(findings) == 0 {
+			// Keep structured output parseable for CI pipelines
+			if output.Format(outputFormat) != output.FormatTable {
+				return output.Print([]auditFinding{}, output.Format(outputFormat), nil, nil)
+			}
+			output.PrintSuccess("No firewall issues found")
+			return nil
+		}
+
+		sort.SliceStable(findings, func(i, j int) bool {
+			return severityRank[findings[i].Severity] > severityRank[findings[j].Severity]
+		})
+
+		dataSlice := make([]interface{}, len(findings))
+		for i, f := range findings {
+			dataSlice[i] = f
+		}
+
+		if err := output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"SEVERITY", "CHECK", "REGION", "IP ADDRESS", "RULE", "DETAIL"},
+			func(item interface{}) []string {
+				f := item.(auditFinding)
+				return []string{strings.ToUpper(f.Severity), f.Check, f.Region, valueOr(f.IPAddress, "-"), f.RuleUUID, f.Detail}
+			},
+		); err != nil {
+			return err
+		}
+
+		failing := 0
+		for _, f := range findings {
+			if severityRank[f.Severity] >= threshold {
+				failing++
+			}
+		}
+		if failing > 0 {
+			return fmt.Errorf("%d firewall finding(s) at or above severity %s", failing, policy.FailOn)
+		}
+
+		return nil
+	},
+}
+
+// severityRank orders finding severities
+var severityRank = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// auditPolicy configures the firewall audit checks
+type auditPolicy struct {
+	AdminPorts     []int             `yaml:"adminPorts"`
+	MaxPortRange   int               `yaml:"maxPortRange"`
+	AllowWorldOpen []string          `yaml:"allowWorldOpen"`
+	Severities     map[string]string `yaml:"severities"`
+	FailOn         string            `yaml:"failOn"`
+}
+
+// defaultAuditPolicy returns the policy used when no policy file is given
+func defaultAuditPolicy() *auditPolicy {
+	return &auditPolicy{
+		AdminPorts:   []int{21, 22, 23, 445, 1433, 2375, 2376, 3306, 3389, 5432, 5900, 6379, 6443, 9200, 11211, 27017},
+		MaxPortRange: 1000,
+		Severities: map[string]string{
+			"world-open-admin-port": "critical",
+			"world-open-all":        "critical",
+			"large-port-range":      "medium",
+			"released-ip":           "medium",
+			"unparsable-rule":       "medium",
+			"duplicate-rule":        "low",
+			"shadowed-rule":         "low",
+		},
+		FailOn: "high",
+	}
+}
+
+// loadAuditPolicy reads a policy file on top of the default policy
+func loadAuditPolicy(path string) (*auditPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	policy := defaultAuditPolicy()
+	defaults := policy.Severities
+	policy.Severities = nil
+
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+
+	for check, severity := range policy.Severities {
+		if _, ok := defaults[check]; !ok {
+			return nil, fmt.Errorf("policy file %s: unknown check '%s'", path, check)
+		}
+		if _, ok := severityRank[severity]; !ok {
+			return nil, fmt.Errorf("policy file %s: invalid severity '%s' for %s", path, severity, check)
+		}
+		defaults[check] = severity
+	}
+	policy.Severities = defaults
+
+	return policy, nil
+}
+
+// auditFinding is a single issue reported by the firewall audit
+type auditFinding struct {
+	Severity  string `json:"severity" yaml:"severity"`
+	Check     string `json:"check" yaml:"check"`
+	Region    string `json:"region" yaml:"region"`
+	IPAddress string `json:"ipAddress" yaml:"ipAddress"`
+	RuleUUID  string `json:"ruleUuid" yaml:"ruleUuid"`
+	Detail    string `json:"detail" yaml:"detail"`
+}
+
+// auditFirewallRules runs every audit check on the rules of one region
+func auditFirewallRules(region string, rules []models.FirewallRule, ips []models.IPAddress, policy *auditPolicy) []auditFinding {
+	var findings []auditFinding
+	add := func(check string, rule models.FirewallRule, detail string) {
+		findings = append(findings, auditFinding{
+			Severity:  policy.Severities[check],
+			Check:     check,
+			Region:    region,
+			IPAddress: rule.IPAddress,
+			RuleUUID:  rule.UUID,
+			Detail:    detail,
+		})
+	}
+
+	allowed := make(map[string]bool)
+	for _, a := range policy.AllowWorldOpen {
+		allowed[strings.ToLower(a)] = true
+	}
+
+	allocated := make(map[string]models.IPAddress)
+	for _, ip := range ips {
+		allocated[ip.UUID] = ip
+		allocated[ip.IpAddress] = ip
+	}
+
+	type parsedRule struct {
+		rule models.FirewallRule
+		spec firewallRuleSpec
+	}
+	var parsed []parsedRule
+
+	for _, rule := range rules {
+		spec, err := firewallRuleSpecFromRule(rule)
+		if err != nil {
+			// The other checks need a parsed rule, so report this one instead
+			add("unparsable-rule", rule, fmt.Sprintf("rule could not be parsed: %v", err))
+			continue
+		}
+		if (spec.Protocol == "tcp" || spec.Protocol == "udp") && spec.StartPort == 0 && spec.EndPort == 0 {
+			// A port-less TCP/UDP rule opens every port
+			spec.StartPort, spec.EndPort = 1, 65535
+		}
+		parsed = append(parsed, parsedRule{rule, spec})
+
+		ip, ok := allocated[rule.IPAddressUUID]
+		if !ok {
+			ip, ok = allocated[rule.IPAddress]
+		}
+		if !ok {
+			add("released-ip", rule, "rule applies to an IP address that is no longer allocated")
+		} else if ip.State != "" && !strings.EqualFold(ip.State, "Allocated") {
+			add("released-ip", rule, fmt.Sprintf("rule applies to an IP address in state %s", ip.State))
+		}
+
+		if !isWorldOpen(spec.Cidrs) {
+			if spec.Protocol != "icmp" && spec.Protocol != "all" && spec.EndPort-spec.StartPort+1 > policy.MaxPortRange {
+				add("large-port-range", rule, fmt.Sprintf("%d ports open (%s)", spec.EndPort-spec.StartPort+1, spec))
+			}
+			continue
+		}
+
+		switch spec.Protocol {
+		case "all":
+			if !allowed["all"] {
+				add("world-open-all", rule, "all protocols and ports open to the internet")
+			}
+			continue
+		case "icmp":
+			continue
+		}
+
+		if allowed[fmt.Sprintf("%s/%d", spec.Protocol, spec.StartPort)] && spec.StartPort == spec.EndPort {
+			continue
+		}
+
+		var exposed []string
+		for _, port := range policy.AdminPorts {
+			if port >= spec.StartPort && port <= spec.EndPort {
+				exposed = append(exposed, fmt.Sprintf("%d", port))
+			}
+		}
+		if len(exposed) > 0 {
+			add("world-open-admin-port", rule, fmt.Sprintf("admin port(s) %s open to the internet", strings.Join(exposed, ",")))
+		}
+
+		if spec.EndPort-spec.StartPort+1 > policy.MaxPortRange {
+			add("large-port-range", rule, fmt.Sprintf("%d ports open to the internet (%s)", spec.EndPort-spec.StartPort+1, spec))
+		}
+	}
+
+	for i, a := range parsed {
+		for j, b := range parsed {
+			if i == j || !sameRuleTarget(a.rule, b.rule) {
+				continue
+			}
+			if a.spec.key() == b.spec.key() {
+				// Report each duplicate pair once, on the later rule
+				if j < i {
+					add("duplicate-rule", a.rule, fmt.Sprintf("identical to rule %s", b.rule.UUID))
+					break
+				}
+				continue
+			}
+			if ruleCovers(b.spec, a.spec) {
+				add("shadowed-rule", a.rule, fmt.Sprintf("fully covered by rule %s (%s)", b.rule.UUID, b.spec))
+				break
+			}
+		}
+	}
+
+	return findings
+}
+
+// sameRuleTarget reports whether two rules apply to the same public IP
+func sameRuleTarget(a, b models.FirewallRule) bool {
+	if a.IPAddressUUID != "" && b.IPAddressUUID != "" {
+		return a.IPAddressUUID == b.IPAddressUUID
+	}
+	return a.IPAddress == b.IPAddress
+}
+
+// isWorldOpen reports whether any CIDR allows every source address
+func isWorldOpen(cidrs []string) bool {
+	for _, cidr := range cidrs {
+		if cidr == "0.0.0.0/0" || cidr == "::/0" {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleCovers reports whether rule outer allows all traffic rule inner allows
+func ruleCovers(outer, inner firewallRuleSpec) bool {
+	switch {
+	case outer.Protocol == "all":
+	case outer.Protocol != inner.Protocol:
+		return false
+	case outer.Protocol == "icmp":
+		if (outer.IcmpType != -1 && outer.IcmpType != inner.IcmpType) ||
+			(outer.IcmpCode != -1 && outer.IcmpCode != inner.IcmpCode) {
+			return false
+		}
+	default:
+		if outer.StartPort > inner.StartPort || outer.EndPort < inner.EndPort {
+			return false
+		}
+	}
+
+	for _, innerCIDR := range inner.Cidrs {
+		_, in, err := net.ParseCIDR(innerCIDR)
+		if err != nil {
+			return false
+		}
+		innerOnes, innerBits := in.Mask.Size()
+
+		covered := false
+		for _, outerCIDR := range outer.Cidrs {
+			_, out, err := net.ParseCIDR(outerCIDR)
+			if err != nil {
+				continue
+			}
+			outerOnes, outerBits := out.Mask.Size()
+			if outerBits == innerBits && outerOnes <= innerOnes && out.Contains(in.IP) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+func init() {
+	firewallCmd.AddCommand(firewallAuditCmd)
+
+	firewallAuditCmd.Flags().String("policy", "", "YAML policy file tuning the audit checks")
+	firewallAuditCmd.Flags().String("fail-on", "high", "Exit non-zero if any finding is at or above this severity (low, medium, high, critical)")
+}
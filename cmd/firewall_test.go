@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPlanFirewallSync(t *testing.T) {
+	ips := []models.IPAddress{
+		{UUID: "ip-1", IpAddress: "203.0.113.10"},
+		{UUID: "ip-2", IpAddress: "203.0.113.20"},
+	}
+
+	const file = `
+rules:
+  - ip: 203.0.113.10
+    protocol: tcp
+    ports: ["22", "443"]
+    cidrs: ["10.0.0.0/8"]
+`
+
+	ssh := models.FirewallRule{UUID: "fw-ssh", Protocol: "tcp", StartPort: "22", EndPort: "22", CidrList: "10.0.0.0/8", IPAddressUUID: "ip-1"}
+	https := models.FirewallRule{UUID: "fw-https", Protocol: "TCP", StartPort: "443", CidrList: "10.0.0.0/8", IPAddress: "203.0.113.10"}
+	extra := models.FirewallRule{UUID: "fw-extra", Protocol: "udp", StartPort: "53", EndPort: "53", CidrList: "0.0.0.0/0", IPAddressUUID: "ip-1"}
+	emptyCidr := models.FirewallRule{UUID: "fw-empty", Protocol: "tcp", StartPort: "80", EndPort: "80", CidrList: "", IPAddressUUID: "ip-1"}
+	badCidr := models.FirewallRule{UUID: "fw-bad", Protocol: "tcp", StartPort: "80", EndPort: "80", CidrList: "10.0.0.1/8", IPAddressUUID: "ip-1"}
+	otherIP := models.FirewallRule{UUID: "fw-other", Protocol: "tcp", StartPort: "22", EndPort: "22", CidrList: "0.0.0.0/0", IPAddressUUID: "ip-2"}
+
+	tests := []struct {
+		name          string
+		existing      []models.FirewallRule
+		prune         bool
+		wantAdd       []string
+		wantRemove    []string
+		wantInvalid   []string
+		wantUnchanged int
+		wantUnmanaged int
+	}{
+		{
+			name:    "create missing rules",
+			wantAdd: []string{"tcp/22 from 10.0.0.0/8", "tcp/443 from 10.0.0.0/8"},
+		},
+		{
+			name:          "keep existing rules",
+			existing:      []models.FirewallRule{ssh, https},
+			wantUnchanged: 2,
+		},
+		{
+			name:          "keep duplicates of a desired rule as unmanaged",
+			existing:      []models.FirewallRule{ssh, ssh},
+			wantAdd:       []string{"tcp/443 from 10.0.0.0/8"},
+			wantUnchanged: 1,
+			wantUnmanaged: 1,
+		},
+		{
+			name:          "leave unlisted rules without prune",
+			existing:      []models.FirewallRule{ssh, https, extra},
+			wantUnchanged: 2,
+			wantUnmanaged: 1,
+		},
+		{
+			name:          "remove unlisted rules with prune",
+			existing:      []models.FirewallRule{ssh, https, extra},
+			prune:         true,
+			wantRemove:    []string{"fw-extra"},
+			wantUnchanged: 2,
+		},
+		{
+			name:          "ignore rules on IPs not in the file",
+			existing:      []models.FirewallRule{ssh, https, otherIP},
+			prune:         true,
+			wantUnchanged: 2,
+		},
+		{
+			name:          "report invalid rules without prune",
+			existing:      []models.FirewallRule{ssh, https, emptyCidr, badCidr},
+			wantInvalid:   []string{"fw-empty", "fw-bad"},
+			wantUnchanged: 2,
+			wantUnmanaged: 2,
+		},
+		{
+			name:          "remove invalid rules with prune",
+			existing:      []models.FirewallRule{ssh, emptyCidr},
+			prune:         true,
+			wantAdd:       []string{"tcp/443 from 10.0.0.0/8"},
+			wantRemove:    []string{"fw-empty"},
+			wantInvalid:   []string{"fw-empty"},
+			wantUnchanged: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		var ruleFile firewallRuleFile
+		if err := yaml.Unmarshal([]byte(file), &ruleFile); err != nil {
+			t.Fatalf("yaml.Unmarshal: %v", err)
+		}
+
+		plan, err := planFirewallSync(&ruleFile, ips, tt.existing, tt.prune)
+		if err != nil {
+			t.Errorf("%s: planFirewallSync: %v", tt.name, err)
+			continue
+		}
+
+		var add, remove, invalid []string
+		for _, a := range plan.Add {
+			add = append(add, a.Spec.String())
+		}
+		for _, r := range plan.Remove {
+			remove = append(remove, r.Rule.UUID)
+		}
+		for _, r := range plan.Invalid {
+			invalid = append(invalid, r.Rule.UUID)
+		}
+
+		if !equalStrings(add, tt.wantAdd) {
+			t.Errorf("%s: Add = %v, want %v", tt.name, add, tt.wantAdd)
+		}
+		if !equalStrings(remove, tt.wantRemove) {
+			t.Errorf("%s: Remove = %v, want %v", tt.name, remove, tt.wantRemove)
+		}
+		if !equalStrings(invalid, tt.wantInvalid) {
+			t.Errorf("%s: Invalid = %v, want %v", tt.name, invalid, tt.wantInvalid)
+		}
+		if plan.Unchanged != tt.wantUnchanged || plan.Unmanaged != tt.wantUnmanaged {
+			t.Errorf("%s: Unchanged/Unmanaged = %d/%d, want %d/%d", tt.name, plan.Unchanged, plan.Unmanaged, tt.wantUnchanged, tt.wantUnmanaged)
+		}
+	}
+}
+
+func TestPlanFirewallSyncErrors(t *testing.T) {
+	ips := []models.IPAddress{{UUID: "ip-1", IpAddress: "203.0.113.10"}}
+
+	tests := []struct {
+		name string
+		file string
+	}{
+		{"unknown IP", `rules: [{ip: 198.51.100.1, protocol: tcp, ports: ["22"], cidrs: ["0.0.0.0/0"]}]`},
+		{"invalid protocol", `rules: [{ip: 203.0.113.10, protocol: gre, cidrs: ["0.0.0.0/0"]}]`},
+		{"icmp type on tcp", `rules: [{ip: 203.0.113.10, protocol: tcp, ports: ["22"], cidrs: ["0.0.0.0/0"], icmpType: 8}]`},
+	}
+
+	for _, tt := range tests {
+		var ruleFile firewallRuleFile
+		if err := yaml.Unmarshal([]byte(tt.file), &ruleFile); err != nil {
+			t.Fatalf("%s: yaml.Unmarshal: %v", tt.name, err)
+		}
+		if _, err := planFirewallSync(&ruleFile, ips, nil, true); err == nil {
+			t.Errorf("%s: planFirewallSync: want an error", tt.name)
+		}
+	}
+}
+
+// equalStrings compares two string slices, treating nil and empty as equal
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
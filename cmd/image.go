@@ -0,0 +1,422 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// imageFormats are the disk formats accepted by image register
+var imageFormats = []string{"qcow2", "raw", "vhd", "vmdk", "ova"}
+
+// imageCmd represents the image command
+var imageCmd = &cobra.Command{
+	Use:     "image",
+	Aliases: []string{"images", "template"},
+	Short:   "Manage custom images",
+	Long: `Register images from a URL, create images from volumes or snapshots, export
+them to a download URL and delete them.
+
+To list the images available for new instances use 'sannti compute images'.`,
+}
+
+// imageGetCmd shows an image
+var imageGetCmd = &cobra.Command{
+	Use:   "get <name-or-uuid>",
+	Short: "Get image details",
+	Long: `Show an image. With --download-url the image is exported and a URL it can be
+downloaded from is printed once the export is ready.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		downloadURL, _ := cmd.Flags().GetBool("download-url")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		tpl, err := c.FindTemplate(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if downloadURL {
+			if !tpl.IsReady {
+				return fmt.Errorf("image %s is not ready yet (%s)", tpl.Name, valueOr(tpl.Status, "pending"))
+			}
+			url, err := extractTemplateURL(c, tpl, region, time.Now().Add(timeout))
+			if err != nil {
+				return err
+			}
+			fmt.Println(url)
+			return nil
+		}
+
+		return output.Print(
+			tpl,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "OS TYPE", "FORMAT", "SIZE", "STATUS", "READY", "REGION"},
+			func(item interface{}) []string {
+				tpl := item.(*models.Template)
+				ready := "no"
+				if tpl.IsReady {
+					ready = "yes"
+				}
+				return []string{
+					tpl.UUID, tpl.Name, valueOr(tpl.OsTypeName, "-"), valueOr(tpl.Format, "-"), formatBytes(tpl.Size),
+					valueOr(tpl.Status, "-"), ready, tpl.ZoneName,
+				}
+			},
+		)
+	},
+}
+
+// imageRegisterCmd registers an image from a URL
+var imageRegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register an image from a URL",
+	Long: `Register an image that the platform downloads from --url. --os-type accepts a
+UUID, a full OS type description or a unique part of it; see 'sannti image os-types'.
+
+With --wait the download progress is shown until the image is ready.`,
+	Example: `  sannti image register --name debian-12 --os-type "Debian GNU/Linux 12" \
+    --url https://cloud.debian.org/images/cloud/bookworm/latest/debian-12-generic-amd64.qcow2 --wait`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		imageURL, _ := cmd.Flags().GetString("url")
+		osTypeRef, _ := cmd.Flags().GetString("os-type")
+		format, _ := cmd.Flags().GetString("format")
+		description, _ := cmd.Flags().GetString("description")
+		wait, _ := cmd.Flags().GetBool("wait")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		if name == "" || imageURL == "" || osTypeRef == "" {
+			return fmt.Errorf("required flags: --name, --url, --os-type")
+		}
+
+		format = strings.ToLower(format)
+		if !containsString(imageFormats, format) {
+			return fmt.Errorf("invalid --format %q: must be one of %s", format, strings.Join(imageFormats, ", "))
+		}
+		if !strings.HasPrefix(imageURL, "http://") && !strings.HasPrefix(imageURL, "https://") {
+			return fmt.Errorf("invalid --url %q: must be an http:// or https:// URL", imageURL)
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		osType, err := c.FindOSType(osTypeRef)
+		if err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Registering image '%s' (%s, %s)...", name, osType.Description, format))
+
+		tpl, err := c.RegisterTemplate(models.RegisterTemplateRequest{
+			Name:        name,
+			DisplayText: valueOr(description, name),
+			URL:         imageURL,
+			OsTypeUUID:  osType.UUID,
+			Format:      format,
+			Region:      region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register image: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Image registered: %s (UUID: %s)", tpl.Name, tpl.UUID))
+
+		if !wait {
+			output.PrintInfo(fmt.Sprintf("The image is being downloaded, check progress with 'sannti image get %s'", tpl.UUID))
+			return nil
+		}
+
+		if err := waitForTemplate(c, tpl.UUID, region, time.Now().Add(timeout)); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Image %s is ready", tpl.Name))
+		return nil
+	},
+}
+
+// imageCreateCmd creates an image from a volume or snapshot
+var imageCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an image from a volume or snapshot",
+	Long: `Create an image from a volume (--from-volume) or a volume snapshot
+(--from-snapshot). A volume must be detached or its instance stopped; snapshot
+an attached volume first to image it without downtime.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		volumeRef, _ := cmd.Flags().GetString("from-volume")
+		snapshotRef, _ := cmd.Flags().GetString("from-snapshot")
+		description, _ := cmd.Flags().GetString("description")
+		wait, _ := cmd.Flags().GetBool("wait")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		if name == "" {
+			return fmt.Errorf("required flag: --name")
+		}
+		if (volumeRef == "") == (snapshotRef == "") {
+			return fmt.Errorf("exactly one of --from-volume or --from-snapshot is required")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		req := models.CreateTemplateRequest{
+			Name:        name,
+			DisplayText: valueOr(description, name),
+			Region:      region,
+		}
+
+		var source string
+		if volumeRef != "" {
+			vol, err := c.FindVolume(volumeRef, region)
+			if err != nil {
+				return err
+			}
+			if err := requireVolumeIdle(c, vol, region); err != nil {
+				return err
+			}
+			req.VolumeUUID = vol.UUID
+			source = "volume " + vol.Name
+		} else {
+			snap, err := c.FindSnapshot(snapshotRef, region)
+			if err != nil {
+				return err
+			}
+			req.SnapshotUUID = snap.UUID
+			source = "snapshot " + snap.Name
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating image '%s' from %s...", name, source))
+
+		tpl, err := c.CreateTemplate(req)
+		if err != nil {
+			return fmt.Errorf("failed to create image: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Image created: %s (UUID: %s)", valueOr(tpl.Name, name), tpl.UUID))
+
+		if !wait {
+			return nil
+		}
+
+		if err := waitForTemplate(c, tpl.UUID, region, time.Now().Add(timeout)); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Image %s is ready", valueOr(tpl.Name, name)))
+		return nil
+	},
+}
+
+// imageDeleteCmd deletes an image
+var imageDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete an image",
+	Long: `Delete an image. Instances already launched from it keep running.
+
+You will be asked to type the image name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		tpl, err := c.FindTemplate(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		instances, err := c.ListInstances(region)
+		if err != nil {
+			return fmt.Errorf("failed to list instances: %w", err)
+		}
+
+		var users []string
+		for _, inst := range instances {
+			if inst.TemplateUUID == tpl.UUID {
+				users = append(users, inst.Name)
+			}
+		}
+
+		details := []resourceDetail{
+			{"Name", tpl.Name},
+			{"UUID", tpl.UUID},
+			{"OS type", valueOr(tpl.OsTypeName, "-")},
+		}
+		if len(users) > 0 {
+			details = append(details, resourceDetail{"Used by", strings.Join(users, ", ")})
+		}
+
+		if err := confirmDestructive("image", tpl.Name, details); err != nil {
+			return err
+		}
+
+		if err := c.DeleteTemplate(tpl.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Image %s deleted successfully", tpl.Name))
+		return nil
+	},
+}
+
+// imageOSTypesCmd lists the OS types images can be registered as
+var imageOSTypesCmd = &cobra.Command{
+	Use:   "os-types",
+	Short: "List OS types for image registration",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		osTypes, err := c.ListOSTypes()
+		if err != nil {
+			return fmt.Errorf("failed to list OS types: %w", err)
+		}
+
+		if len(osTypes) == 0 {
+			output.PrintInfo("No OS types found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(osTypes))
+		for i, ost := range osTypes {
+			dataSlice[i] = ost
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "DESCRIPTION", "CATEGORY"},
+			func(item interface{}) []string {
+				ost := item.(models.OSType)
+				return []string{ost.UUID, ost.Description, valueOr(ost.CategoryName, "-")}
+			},
+		)
+	},
+}
+
+// requireVolumeIdle fails unless the volume is detached or its instance is stopped
+func requireVolumeIdle(c *client.Client, vol *models.Volume, region string) error {
+	if vol.VirtualMachineUUID == "" {
+		return nil
+	}
+
+	inst, err := c.GetInstance(vol.VirtualMachineUUID, region)
+	if err != nil {
+		return fmt.Errorf("failed to get instance: %w", err)
+	}
+	if !strings.EqualFold(inst.State, "Stopped") {
+		return fmt.Errorf("volume %s is attached to %s which is %s, run 'sannti compute stop %s' first", vol.Name, inst.Name, inst.State, inst.UUID)
+	}
+
+	return nil
+}
+
+// extractTemplateURL exports an image and polls until its download URL is available
+func extractTemplateURL(c *client.Client, tpl *models.Template, region string, deadline time.Time) (string, error) {
+	extract, err := c.ExtractTemplate(tpl.UUID, region)
+	if err != nil {
+		return "", err
+	}
+
+	lastProgress := -1
+	for extract.URL == "" {
+		state := strings.ToLower(extract.State)
+		if strings.Contains(state, "error") || strings.Contains(state, "failed") {
+			return "", fmt.Errorf("export of image %s failed: %s", tpl.Name, valueOr(extract.Status, extract.State))
+		}
+
+		if extract.UploadPercentage != lastProgress {
+			output.PrintInfo(fmt.Sprintf("Exporting image %s: %d%%", tpl.Name, extract.UploadPercentage))
+			lastProgress = extract.UploadPercentage
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("timed out waiting for the download URL of image %s", tpl.Name)
+		}
+
+		time.Sleep(imagePollInterval)
+
+		if extract, err = c.GetTemplateExtract(extract.UUID); err != nil {
+			return "", fmt.Errorf("failed to get export status: %w", err)
+		}
+	}
+
+	return extract.URL, nil
+}
+
+func init() {
+	rootCmd.AddCommand(imageCmd)
+	imageCmd.AddCommand(imageGetCmd)
+	imageCmd.AddCommand(imageRegisterCmd)
+	imageCmd.AddCommand(imageCreateCmd)
+	imageCmd.AddCommand(imageDeleteCmd)
+	imageCmd.AddCommand(imageOSTypesCmd)
+
+	imageGetCmd.Flags().Bool("download-url", false, "Export the image and print its download URL")
+	imageGetCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait for the download URL")
+
+	imageRegisterCmd.Flags().String("name", "", "Image name (required)")
+	imageRegisterCmd.Flags().String("url", "", "HTTP(S) URL the image is downloaded from (required)")
+	imageRegisterCmd.Flags().String("os-type", "", "OS type: UUID, description or unique part of it (required)")
+	imageRegisterCmd.Flags().String("format", "qcow2", "Disk format: "+strings.Join(imageFormats, ", "))
+	imageRegisterCmd.Flags().String("description", "", "Image description (defaults to the name)")
+	imageRegisterCmd.Flags().Bool("wait", false, "Wait and show progress until the image is ready")
+	imageRegisterCmd.Flags().Duration("timeout", 60*time.Minute, "Maximum time to wait with --wait")
+
+	imageCreateCmd.Flags().String("name", "", "Image name (required)")
+	imageCreateCmd.Flags().String("from-volume", "", "Volume to create the image from (name or UUID)")
+	imageCreateCmd.Flags().String("from-snapshot", "", "Volume snapshot to create the image from (name or UUID)")
+	imageCreateCmd.Flags().String("description", "", "Image description (defaults to the name)")
+	imageCreateCmd.Flags().Bool("wait", false, "Wait until the image is ready")
+	imageCreateCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait with --wait")
+}
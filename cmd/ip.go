@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/sannticloud/sannti-cli/internal/client"
@@ -14,14 +15,14 @@ import (
 var ipCmd = &cobra.Command{
 	Use:   "ip",
 	Short: "Manage IP addresses",
-	Long:  `List and manage Sannti Cloud IP addresses.`,
+	Long:  `List, acquire, release and associate Sannti Cloud public IP addresses.`,
 }
 
 // ipListCmd lists all IP addresses
 var ipListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List IP addresses",
-	Long:  `List all IP addresses, optionally filtered by region.`,
+	Long:  `List all IP addresses, optionally filtered by region and tag selector.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -30,6 +31,12 @@ var ipListCmd = &cobra.Command{
 
 		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 
+		selectorFlag, _ := cmd.Flags().GetString("selector")
+		selector, err := parseSelector(selectorFlag)
+		if err != nil {
+			return err
+		}
+
 		// Use region flag or default
 		region := regionFlag
 		if region == "" {
@@ -41,34 +48,308 @@ var ipListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list IP addresses: %w", err)
 		}
 
+		var matched []models.IPAddress
+		for _, ip := range ips {
+			if matchesSelector(ip.Tags, selector) {
+				matched = append(matched, ip)
+			}
+		}
+		ips = matched
+
 		if len(ips) == 0 {
 			output.PrintInfo("No IP addresses found")
 			return nil
 		}
 
+		// Older API responses only carry the instance UUID of a static NAT
+		instanceNames := make(map[string]string)
+		for _, ip := range ips {
+			if ip.VirtualMachineUUID != "" && ip.VirtualMachineName == "" {
+				instances, err := c.ListInstances(region)
+				if err != nil {
+					return fmt.Errorf("failed to list instances: %w", err)
+				}
+				for _, inst := range instances {
+					instanceNames[inst.UUID] = inst.Name
+				}
+				break
+			}
+		}
+
+		// Port forwards are best-effort; without them the column is dropped
+		forwards, err := c.ListPortForwardingRules(region)
+		showForwards := err == nil
+		if err != nil {
+			output.PrintError(fmt.Sprintf("Warning: port forwarding rules unavailable: %v", err))
+		}
+
 		// Convert to interface slice
 		dataSlice := make([]interface{}, len(ips))
 		for i, ip := range ips {
 			dataSlice[i] = ip
 		}
 
+		headers := []string{"UUID", "IP ADDRESS", "STATE", "REGION", "ATTACHED TO", "TAGS"}
+		if showForwards {
+			headers = []string{"UUID", "IP ADDRESS", "STATE", "REGION", "ATTACHED TO", "PORT FORWARDS", "TAGS"}
+		}
+
 		return output.Print(
 			dataSlice,
 			output.Format(outputFormat),
-			[]string{"UUID", "IP ADDRESS", "STATE", "REGION", "ATTACHED TO"},
+			headers,
 			func(item interface{}) []string {
 				ip := item.(models.IPAddress)
-				attached := "-"
-				if "-" != "" {
-					attached = "-"
+
+				if !showForwards {
+					return []string{ip.UUID, ip.IpAddress, ip.State, ip.ZoneName, ipAttachment(ip, instanceNames), formatTags(ip.Tags)}
+				}
+
+				var rules []string
+				for _, rule := range portForwardsForIP(forwards, ip) {
+					rules = append(rules, formatPortForward(rule))
 				}
-				return []string{ip.UUID, ip.IpAddress, ip.State, ip.ZoneName, attached}
+
+				return []string{ip.UUID, ip.IpAddress, ip.State, ip.ZoneName, ipAttachment(ip, instanceNames), valueOr(strings.Join(rules, ","), "-"), formatTags(ip.Tags)}
 			},
 		)
 	},
 }
 
+// ipAcquireCmd allocates a new public IP address
+var ipAcquireCmd = &cobra.Command{
+	Use:   "acquire",
+	Short: "Acquire a public IP address",
+	Long:  `Allocate a new public IP address for a network.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		networkRef, _ := cmd.Flags().GetString("network")
+		tagPairs, _ := cmd.Flags().GetStringArray("tag")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if networkRef == "" {
+			return fmt.Errorf("required flag: --network")
+		}
+
+		tags, err := parseTags(tagPairs)
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		network, err := c.FindNetwork(networkRef, region)
+		if err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Acquiring public IP address for network %s...", network.Name))
+
+		ip, err := c.AcquireIPAddress(network.UUID, region)
+		if err != nil {
+			return fmt.Errorf("failed to acquire IP address: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("IP address acquired: %s (UUID: %s)", ip.IpAddress, ip.UUID))
+
+		if len(tags) > 0 {
+			if err := c.CreateTags("ip", ip.UUID, tags); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Tagged IP address with %s", formatTags(tags)))
+		}
+
+		return nil
+	},
+}
+
+// ipReleaseCmd releases a public IP address
+var ipReleaseCmd = &cobra.Command{
+	Use:   "release <ip-or-uuid>",
+	Short: "Release a public IP address",
+	Long: `Release a public IP address back to the pool. Firewall and port forwarding
+rules on the address are removed with it. The source NAT address of a network
+cannot be released.
+
+You will be asked to type the IP address to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		ip, err := c.FindIPAddress(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if ip.IsSourceNat {
+			return fmt.Errorf("%s is the source NAT address of network %s and cannot be released", ip.IpAddress, valueOr(ip.NetworkName, ip.NetworkUUID))
+		}
+
+		if err := confirmDestructive("IP address", ip.IpAddress, []resourceDetail{
+			{"Address", ip.IpAddress},
+			{"UUID", ip.UUID},
+			{"Region", ip.ZoneName},
+			{"Attached to", ipAttachment(*ip, nil)},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.ReleaseIPAddress(ip.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("IP address %s released successfully", ip.IpAddress))
+		return nil
+	},
+}
+
+// ipAssociateCmd enables static NAT from a public IP to an instance
+var ipAssociateCmd = &cobra.Command{
+	Use:   "associate <ip-or-uuid>",
+	Short: "Associate a public IP address with an instance",
+	Long: `Enable static NAT so that all traffic to the public IP address is forwarded
+to an instance. The instance must be on the network the address belongs to.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		instanceRef, _ := cmd.Flags().GetString("instance")
+		if instanceRef == "" {
+			return fmt.Errorf("required flag: --instance")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		ip, err := c.FindIPAddress(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if ip.IsSourceNat {
+			return fmt.Errorf("%s is the source NAT address of network %s and cannot be associated with an instance", ip.IpAddress, valueOr(ip.NetworkName, ip.NetworkUUID))
+		}
+		if hasStaticNat(*ip) {
+			return fmt.Errorf("%s is already associated with instance %s, run 'sannti ip disassociate %s' first", ip.IpAddress, valueOr(ip.VirtualMachineName, ip.VirtualMachineUUID), ip.IpAddress)
+		}
+
+		inst, err := c.FindInstance(instanceRef, region)
+		if err != nil {
+			return err
+		}
+
+		if ip.NetworkName != "" && inst.NetworkName != "" && ip.NetworkName != inst.NetworkName {
+			return fmt.Errorf("%s belongs to network %s but instance %s is on %s", ip.IpAddress, ip.NetworkName, inst.Name, inst.NetworkName)
+		}
+
+		if err := c.EnableStaticNat(ip.UUID, inst.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("IP address %s associated with instance %s", ip.IpAddress, inst.Name))
+		return nil
+	},
+}
+
+// ipDisassociateCmd disables static NAT on a public IP
+var ipDisassociateCmd = &cobra.Command{
+	Use:   "disassociate <ip-or-uuid>",
+	Short: "Disassociate a public IP address from its instance",
+	Long:  `Disable static NAT on a public IP address. The address stays allocated.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		ip, err := c.FindIPAddress(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if !hasStaticNat(*ip) {
+			return fmt.Errorf("%s is not associated with an instance", ip.IpAddress)
+		}
+
+		if err := c.DisableStaticNat(ip.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("IP address %s disassociated from instance %s", ip.IpAddress, valueOr(ip.VirtualMachineName, ip.VirtualMachineUUID)))
+		return nil
+	},
+}
+
+// hasStaticNat reports whether a public IP forwards all traffic to an instance.
+// Older API responses only set the instance UUID, newer ones the flag.
+func hasStaticNat(ip models.IPAddress) bool {
+	return ip.StaticNatEnabled || ip.VirtualMachineUUID != ""
+}
+
+// ipAttachment describes what a public IP address is attached to. Instance
+// names missing from the API response are looked up in instanceNames.
+func ipAttachment(ip models.IPAddress, instanceNames map[string]string) string {
+	switch {
+	case hasStaticNat(ip):
+		name := ip.VirtualMachineName
+		if name == "" {
+			name = valueOr(instanceNames[ip.VirtualMachineUUID], valueOr(ip.VirtualMachineUUID, "unknown"))
+		}
+		return "instance/" + name + " (static NAT)"
+	case ip.IsSourceNat:
+		return "network/" + valueOr(ip.NetworkName, ip.NetworkUUID) + " (source NAT)"
+	case ip.NetworkName != "" || ip.NetworkUUID != "":
+		return "network/" + valueOr(ip.NetworkName, ip.NetworkUUID)
+	default:
+		return "-"
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(ipCmd)
 	ipCmd.AddCommand(ipListCmd)
+	ipCmd.AddCommand(ipAcquireCmd)
+	ipCmd.AddCommand(ipReleaseCmd)
+	ipCmd.AddCommand(ipAssociateCmd)
+	ipCmd.AddCommand(ipDisassociateCmd)
+
+	ipListCmd.Flags().String("selector", "", "Filter by tags, e.g. env=prod,team=web")
+
+	ipAcquireCmd.Flags().String("network", "", "Network to acquire the address for (name or UUID)")
+	ipAcquireCmd.Flags().StringArray("tag", nil, "Tag as key=value (repeatable)")
+
+	ipAssociateCmd.Flags().String("instance", "", "Instance to forward traffic to (name or UUID)")
 }
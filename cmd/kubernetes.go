@@ -1,12 +1,18 @@
 package cmd
 
 import (
+"errors"
 "fmt"
+"strconv"
+"strings"
+"time"
 
 "github.com/spf13/cobra"
 
 "github.com/sannticloud/sannti-cli/internal/client"
 "github.com/sannticloud/sannti-cli/internal/config"
+"github.com/sannticloud/sannti-cli/internal/k8s"
+"github.com/sannticloud/sannti-cli/internal/kubeconfig"
 "github.com/sannticloud/sannti-cli/internal/models"
 "github.com/sannticloud/sannti-cli/internal/output"
 )
@@ -16,7 +22,7 @@ var k8sCmd = &cobra.Command{
 Use:     "k8s",
 Aliases: []string{"kubernetes"},
 Short:   "Manage Kubernetes clusters",
-Long:    `List available Kubernetes versions for cluster creation.`,
+Long:    `Create, list, and manage Sannti Cloud Kubernetes clusters.`,
 }
 
 // k8sVersionsCmd lists available Kubernetes versions
@@ -71,7 +77,1274 @@ fmt.Sprintf("%d", v.MinMemory),
 },
 }
 
+// k8sListCmd lists Kubernetes clusters
+var k8sListCmd = &cobra.Command{
+Use:   "list",
+Short: "List Kubernetes clusters",
+Long:  `List all Kubernetes clusters, optionally filtered by region and tag selector.`,
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+selectorFlag, _ := cmd.Flags().GetString("selector")
+selector, err := parseSelector(selectorFlag)
+if err != nil {
+return err
+}
+
+region := regionFlag
+if region == "" {
+region = cfg.DefaultRegion
+}
+
+clusters, err := c.ListKubernetesClusters("")
+if err != nil {
+return fmt.Errorf("failed to list Kubernetes clusters: %w", err)
+}
+
+// The cluster list endpoint has no zone filter, so filter by region here
+var matched []models.KubernetesCluster
+for _, cluster := range clusters {
+if region != "" && cluster.ZoneName != region {
+continue
+}
+if matchesSelector(cluster.Tags, selector) {
+matched = append(matched, cluster)
+}
+}
+clusters = matched
+
+if len(clusters) == 0 {
+output.PrintInfo("No Kubernetes clusters found")
+return nil
+}
+
+dataSlice := make([]interface{}, len(clusters))
+for i, cluster := range clusters {
+dataSlice[i] = cluster
+}
+
+return output.Print(
+dataSlice,
+output.Format(outputFormat),
+[]string{"UUID", "NAME", "STATE", "REGION", "VERSION", "NODES", "TAGS"},
+func(item interface{}) []string {
+cluster := item.(models.KubernetesCluster)
+return []string{
+cluster.UUID,
+cluster.Name,
+cluster.State,
+cluster.ZoneName,
+cluster.KubernetesVersion,
+fmt.Sprintf("%d", cluster.Size),
+formatTags(cluster.Tags),
+}
+},
+)
+},
+}
+
+// k8sGetCmd gets a specific cluster
+var k8sGetCmd = &cobra.Command{
+Use:   "get <name-or-uuid>",
+Short: "Get Kubernetes cluster details",
+Long:  `Get detailed information about a specific Kubernetes cluster.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return fmt.Errorf("failed to get Kubernetes cluster: %w", err)
+}
+
+return output.Print(
+cluster,
+output.Format(outputFormat),
+[]string{"UUID", "NAME", "STATE", "REGION", "VERSION", "CONTROL NODES", "WORKER NODES", "IP ADDRESS"},
+func(item interface{}) []string {
+cluster := item.(*models.KubernetesCluster)
+
+ip := cluster.IPAddress
+if ip == "" {
+ip = "-"
+}
+
+return []string{
+cluster.UUID,
+cluster.Name,
+cluster.State,
+cluster.ZoneName,
+cluster.KubernetesVersion,
+fmt.Sprintf("%d", cluster.ControlNodes),
+fmt.Sprintf("%d", cluster.Size),
+ip,
+}
+},
+)
+},
+}
+
+// k8sCreateCmd creates a new cluster
+var k8sCreateCmd = &cobra.Command{
+Use:   "create",
+Short: "Create a Kubernetes cluster",
+Long: `Create a new Kubernetes cluster.
+
+--version, --node-size, --network and --ssh-key accept either a name or a UUID.
+The node size must meet the minimum CPU and memory of the chosen version.
+
+With --estimate the estimated monthly cost is printed before the cluster is
+created.`,
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+name, _ := cmd.Flags().GetString("name")
+description, _ := cmd.Flags().GetString("description")
+versionRef, _ := cmd.Flags().GetString("version")
+sizeRef, _ := cmd.Flags().GetString("node-size")
+networkRef, _ := cmd.Flags().GetString("network")
+sshKey, _ := cmd.Flags().GetString("ssh-key")
+nodes, _ := cmd.Flags().GetInt("size")
+controlNodes, _ := cmd.Flags().GetInt("control-nodes")
+haEnabled, _ := cmd.Flags().GetBool("ha")
+nodeDisk, _ := cmd.Flags().GetInt64("node-disk")
+tagPairs, _ := cmd.Flags().GetStringArray("tag")
+
+region := regionFlag
+if region == "" {
+region = cfg.DefaultRegion
+}
+
+if name == "" || versionRef == "" || sizeRef == "" || networkRef == "" {
+return fmt.Errorf("required flags: --name, --version, --node-size, --network")
+}
+
+if nodes < 1 {
+return fmt.Errorf("--size must be at least 1")
+}
+
+if haEnabled && !cmd.Flags().Changed("control-nodes") {
+controlNodes = 3
+}
+if controlNodes < 1 {
+return fmt.Errorf("--control-nodes must be at least 1")
+}
+if haEnabled && controlNodes < 2 {
+return fmt.Errorf("--ha requires at least 2 control nodes")
+}
+
+tags, err := parseTags(tagPairs)
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+version, err := c.FindKubernetesVersion(versionRef, region)
+if err != nil {
+return err
+}
+
+offering, err := c.FindComputeOffering(sizeRef, region)
+if err != nil {
+return err
+}
+
+if err := checkOfferingMeetsVersion(offering, version); err != nil {
+return err
+}
+
+network, err := c.FindNetwork(networkRef, region)
+if err != nil {
+return err
+}
+
+if sshKey != "" {
+if _, err := c.GetSSHKey(sshKey, region); err != nil {
+return err
+}
+}
+
+req := models.CreateKubernetesRequest{
+Name:                  name,
+Description:           description,
+Region:                region,
+KubernetesVersionUUID: version.UUID,
+ComputeOfferingUUID:   offering.UUID,
+NetworkUUID:           network.UUID,
+Size:                  nodes,
+ControlNodes:          controlNodes,
+HAEnabled:             haEnabled,
+SSHKeyName:            sshKey,
+NodeRootDiskSize:      nodeDisk,
+}
+
+if estimate, _ := cmd.Flags().GetBool("estimate"); estimate {
+cost, err := c.EstimateKubernetesCost(models.CostEstimateRequest{
+ComputeOfferingUUID:   offering.UUID,
+KubernetesVersionUUID: version.UUID,
+DiskSize:              nodeDisk,
+Count:                 nodes,
+ControlNodes:          controlNodes,
+Hours:                 hoursPerMonth,
+Region:                region,
+})
+if err != nil {
+return err
+}
+printEstimateSummary(cost, fmt.Sprintf("cluster '%s'", name))
+}
+
+output.PrintInfo(fmt.Sprintf("Creating Kubernetes cluster '%s' (version %s) in region '%s'...", name, version.Name, region))
+
+cluster, err := c.CreateKubernetesCluster(req)
+if err != nil {
+return fmt.Errorf("failed to create Kubernetes cluster: %w", err)
+}
+
+output.PrintSuccess(fmt.Sprintf("Kubernetes cluster created: %s (UUID: %s)", cluster.Name, cluster.UUID))
+
+if len(tags) > 0 {
+if err := c.CreateTags("k8s", cluster.UUID, tags); err != nil {
+return err
+}
+output.PrintSuccess(fmt.Sprintf("Tagged cluster with %s", formatTags(tags)))
+}
+
+if wait, _ := cmd.Flags().GetBool("wait"); wait {
+timeout, _ := cmd.Flags().GetDuration("timeout")
+return waitAndReportHealth(c, cluster, timeout, false)
+}
+
+return nil
+},
+}
+
+// k8sDeleteCmd deletes a cluster
+var k8sDeleteCmd = &cobra.Command{
+Use:   "delete <name-or-uuid>",
+Short: "Delete a Kubernetes cluster",
+Long: `Delete a Kubernetes cluster and all of its nodes. This action cannot be undone.
+
+You will be asked to type the cluster name to confirm. Use --yes to skip
+the prompt, which is required when stdin is not a terminal.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+if err := confirmDestructive("Kubernetes cluster", cluster.Name, []resourceDetail{
+{"Name", cluster.Name},
+{"UUID", cluster.UUID},
+{"Region", cluster.ZoneName},
+{"State", cluster.State},
+{"Version", cluster.KubernetesVersion},
+{"Nodes", fmt.Sprintf("%d control, %d worker", cluster.ControlNodes, cluster.Size)},
+{"IP Address", cluster.IPAddress},
+}); err != nil {
+return err
+}
+
+output.PrintInfo(fmt.Sprintf("Deleting Kubernetes cluster %s...", cluster.Name))
+
+if err := c.DeleteKubernetesCluster(cluster.UUID); err != nil {
+return err
+}
+
+output.PrintSuccess(fmt.Sprintf("Kubernetes cluster %s deleted successfully", cluster.Name))
+return nil
+},
+}
+
+// k8sKubeconfigCmd retrieves the kubeconfig of a cluster
+var k8sKubeconfigCmd = &cobra.Command{
+Use:   "kubeconfig <name-or-uuid>",
+Short: "Get the kubeconfig of a Kubernetes cluster",
+Long: `Print the kubeconfig of a Kubernetes cluster, or merge it into your local kubeconfig.
+
+With --merge the cluster is added to ~/.kube/config (or the first file in
+$KUBECONFIG) under a context named sannti-<region>-<cluster>. Existing entries
+with that name are only replaced when --force is given.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+merge, _ := cmd.Flags().GetBool("merge")
+force, _ := cmd.Flags().GetBool("force")
+switchContext, _ := cmd.Flags().GetBool("switch-context")
+path, _ := cmd.Flags().GetString("kubeconfig")
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+data, err := c.GetKubernetesConfig(cluster.UUID)
+if err != nil {
+return err
+}
+
+if !merge {
+fmt.Print(data)
+return nil
+}
+
+src, err := kubeconfig.Parse([]byte(data))
+if err != nil {
+return err
+}
+
+if path == "" {
+path, err = kubeconfig.DefaultPath()
+if err != nil {
+return err
+}
+}
+
+dst, err := kubeconfig.Load(path)
+if err != nil {
+return err
+}
+
+contextName := fmt.Sprintf("sannti-%s-%s", cluster.ZoneName, cluster.Name)
+if err := kubeconfig.Merge(dst, src, contextName, force, switchContext); err != nil {
+return fmt.Errorf("%w (use --force to overwrite)", err)
+}
+
+if err := dst.Save(path); err != nil {
+return err
+}
+
+output.PrintSuccess(fmt.Sprintf("Merged context '%s' into %s", contextName, path))
+if dst.CurrentContext == contextName {
+output.PrintInfo(fmt.Sprintf("Current context is now '%s'", contextName))
+}
+return nil
+},
+}
+
+// k8sScaleCmd changes the number of worker nodes
+var k8sScaleCmd = &cobra.Command{
+Use:   "scale <name-or-uuid>",
+Short: "Scale a Kubernetes cluster",
+Long:  `Change the number of worker nodes of a Kubernetes cluster.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+size, _ := cmd.Flags().GetInt("size")
+
+if !cmd.Flags().Changed("size") {
+return fmt.Errorf("required flag: --size")
+}
+if size < 1 {
+return fmt.Errorf("--size must be at least 1")
+}
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+if cluster.Size == size {
+output.PrintInfo(fmt.Sprintf("Kubernetes cluster %s already has %d worker nodes", cluster.Name, size))
+return nil
+}
+
+output.PrintInfo(fmt.Sprintf("Scaling Kubernetes cluster %s from %d to %d worker nodes...", cluster.Name, cluster.Size, size))
+
+if err := c.ScaleKubernetesCluster(cluster.UUID, size); err != nil {
+return err
+}
+
+return finishClusterOperation(cmd, c, cluster, clusterScaledTo(size), fmt.Sprintf("Kubernetes cluster %s scaled to %d worker nodes", cluster.Name, size))
+},
+}
+
+// k8sStartCmd starts a cluster
+var k8sStartCmd = &cobra.Command{
+Use:   "start <name-or-uuid>",
+Short: "Start a Kubernetes cluster",
+Long:  `Start a stopped Kubernetes cluster.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+output.PrintInfo(fmt.Sprintf("Starting Kubernetes cluster %s...", cluster.Name))
+
+if err := c.StartKubernetesCluster(cluster.UUID); err != nil {
+return err
+}
+
+return finishClusterOperation(cmd, c, cluster, clusterInState("Running"), fmt.Sprintf("Kubernetes cluster %s started successfully", cluster.Name))
+},
+}
+
+// k8sStopCmd stops a cluster
+var k8sStopCmd = &cobra.Command{
+Use:   "stop <name-or-uuid>",
+Short: "Stop a Kubernetes cluster",
+Long:  `Stop a running Kubernetes cluster and all of its nodes.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+output.PrintInfo(fmt.Sprintf("Stopping Kubernetes cluster %s...", cluster.Name))
+
+if err := c.StopKubernetesCluster(cluster.UUID); err != nil {
+return err
+}
+
+return finishClusterOperation(cmd, c, cluster, clusterInState("Stopped"), fmt.Sprintf("Kubernetes cluster %s stopped successfully", cluster.Name))
+},
+}
+
+// k8sUpgradeCmd upgrades a cluster to a newer version
+var k8sUpgradeCmd = &cobra.Command{
+Use:   "upgrade <name-or-uuid>",
+Short: "Upgrade a Kubernetes cluster",
+Long: `Upgrade a Kubernetes cluster to a newer version.
+
+The target version must be newer than the current one and listed by
+'sannti k8s versions' for the cluster's region.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+versionRef, _ := cmd.Flags().GetString("version")
+
+if versionRef == "" {
+return fmt.Errorf("required flag: --version")
+}
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+version, err := c.FindKubernetesVersion(versionRef, cluster.ZoneName)
+if err != nil {
+return err
+}
+
+if compareVersions(version.Name, cluster.KubernetesVersion) <= 0 {
+return fmt.Errorf("version %s is not newer than the cluster's current version %s", version.Name, cluster.KubernetesVersion)
+}
+
+output.PrintInfo(fmt.Sprintf("Upgrading Kubernetes cluster %s from %s to %s...", cluster.Name, cluster.KubernetesVersion, version.Name))
+
+if err := c.UpgradeKubernetesCluster(cluster.UUID, version.UUID); err != nil {
+return err
+}
+
+return finishClusterOperation(cmd, c, cluster, clusterAtVersion(version.Name), fmt.Sprintf("Kubernetes cluster %s upgraded to %s", cluster.Name, version.Name))
+},
+}
+
+// k8sNodesCmd lists the VMs of a cluster
+var k8sNodesCmd = &cobra.Command{
+Use:   "nodes <name-or-uuid>",
+Short: "List the nodes of a Kubernetes cluster",
+Long:  `List the control plane and worker VMs that make up a Kubernetes cluster.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+nodes, err := c.ListKubernetesNodes(cluster.UUID)
+if err != nil {
+return fmt.Errorf("failed to list Kubernetes nodes: %w", err)
+}
+
+if len(nodes) == 0 {
+output.PrintInfo("No nodes found")
+return nil
+}
+
+dataSlice := make([]interface{}, len(nodes))
+for i, n := range nodes {
+dataSlice[i] = n
+}
+
+return output.Print(
+dataSlice,
+output.Format(outputFormat),
+[]string{"UUID", "NAME", "ROLE", "NODE POOL", "STATE", "PRIVATE IP", "PUBLIC IP"},
+func(item interface{}) []string {
+n := item.(models.KubernetesNode)
+pool := n.NodePoolName
+if pool == "" {
+pool = "-"
+}
+publicIP := n.PublicIP
+if publicIP == "" {
+publicIP = "-"
+}
+return []string{n.UUID, n.Name, n.Role, pool, n.State, n.PrivateIP, publicIP}
+},
+)
+},
+}
+
+// k8sNodePoolCmd groups node pool commands
+var k8sNodePoolCmd = &cobra.Command{
+Use:     "nodepool",
+Aliases: []string{"nodepools", "np"},
+Short:   "Manage Kubernetes node pools",
+Long:    `Add, list, scale and delete node pools, groups of worker nodes sharing a compute size.`,
+}
+
+// k8sNodePoolListCmd lists the node pools of a cluster
+var k8sNodePoolListCmd = &cobra.Command{
+Use:   "list <cluster>",
+Short: "List node pools",
+Long:  `List the node pools of a Kubernetes cluster.`,
+Args:  cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+pools, err := c.ListNodePools(cluster.UUID)
+if err != nil {
+return fmt.Errorf("failed to list node pools: %w", err)
+}
+
+if len(pools) == 0 {
+output.PrintInfo("No node pools found")
+return nil
+}
+
+dataSlice := make([]interface{}, len(pools))
+for i, p := range pools {
+dataSlice[i] = p
+}
+
+return output.Print(
+dataSlice,
+output.Format(outputFormat),
+[]string{"UUID", "NAME", "SIZE", "NODES", "STATE", "LABELS", "TAINTS"},
+func(item interface{}) []string {
+p := item.(models.KubernetesNodePool)
+return []string{
+p.UUID,
+p.Name,
+p.ComputeOfferingName,
+fmt.Sprintf("%d", p.Size),
+p.State,
+formatLabels(p.Labels),
+formatTaints(p.Taints),
+}
+},
+)
+},
+}
+
+// k8sNodePoolAddCmd adds a node pool to a cluster
+var k8sNodePoolAddCmd = &cobra.Command{
+Use:   "add <cluster>",
+Short: "Add a node pool",
+Long: `Add a node pool to a Kubernetes cluster.
+
+--node-size accepts a name or UUID and must meet the minimum CPU and memory
+of the cluster's Kubernetes version. Labels and taints are applied to every
+node in the pool where the platform supports them.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+name, _ := cmd.Flags().GetString("name")
+sizeRef, _ := cmd.Flags().GetString("node-size")
+count, _ := cmd.Flags().GetInt("count")
+nodeDisk, _ := cmd.Flags().GetInt64("node-disk")
+labelPairs, _ := cmd.Flags().GetStringArray("label")
+taintSpecs, _ := cmd.Flags().GetStringArray("taint")
+
+if name == "" || sizeRef == "" {
+return fmt.Errorf("required flags: --name, --node-size")
+}
+if count < 1 {
+return fmt.Errorf("--count must be at least 1")
+}
+
+labels, err := parseLabels(labelPairs)
+if err != nil {
+return err
+}
+
+taints, err := parseTaints(taintSpecs)
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+offering, err := c.FindComputeOffering(sizeRef, cluster.ZoneName)
+if err != nil {
+return err
+}
+
+// Skip the minimum check if the cluster runs a version no longer offered
+if version, err := c.FindKubernetesVersion(cluster.KubernetesVersion, cluster.ZoneName); err == nil {
+if err := checkOfferingMeetsVersion(offering, version); err != nil {
+return err
+}
+}
+
+output.PrintInfo(fmt.Sprintf("Adding node pool '%s' (%d x %s) to cluster %s...", name, count, offering.Name, cluster.Name))
+
+pool, err := c.CreateNodePool(models.CreateNodePoolRequest{
+ClusterUUID:         cluster.UUID,
+Name:                name,
+ComputeOfferingUUID: offering.UUID,
+Size:                count,
+NodeRootDiskSize:    nodeDisk,
+Labels:              labels,
+Taints:              taints,
+})
+if err != nil {
+return fmt.Errorf("failed to create node pool: %w", err)
+}
+
+output.PrintSuccess(fmt.Sprintf("Node pool created: %s (UUID: %s)", pool.Name, pool.UUID))
+return nil
+},
+}
+
+// k8sNodePoolScaleCmd changes the size of a node pool
+var k8sNodePoolScaleCmd = &cobra.Command{
+Use:   "scale <cluster> <pool>",
+Short: "Scale a node pool",
+Long:  `Change the number of nodes in a node pool.`,
+Args:  cobra.ExactArgs(2),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+count, _ := cmd.Flags().GetInt("count")
+
+if !cmd.Flags().Changed("count") {
+return fmt.Errorf("required flag: --count")
+}
+if count < 1 {
+return fmt.Errorf("--count must be at least 1, use 'sannti k8s nodepool delete' to remove a pool")
+}
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+pool, err := c.FindNodePool(cluster.UUID, args[1])
+if err != nil {
+return err
+}
+
+output.PrintInfo(fmt.Sprintf("Scaling node pool %s from %d to %d nodes...", pool.Name, pool.Size, count))
+
+if err := c.ScaleNodePool(pool.UUID, count); err != nil {
+return err
+}
+
+output.PrintSuccess(fmt.Sprintf("Node pool %s scaled to %d nodes", pool.Name, count))
+return nil
+},
+}
+
+// k8sNodePoolDeleteCmd removes a node pool
+var k8sNodePoolDeleteCmd = &cobra.Command{
+Use:   "delete <cluster> <pool>",
+Short: "Delete a node pool",
+Long: `Delete a node pool and all of its nodes.
+
+You will be asked to type the node pool name to confirm. Use --yes to skip the prompt.`,
+Args: cobra.ExactArgs(2),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+pool, err := c.FindNodePool(cluster.UUID, args[1])
+if err != nil {
+return err
+}
+
+if err := confirmDestructive("node pool", pool.Name, []resourceDetail{
+{"Name", pool.Name},
+{"UUID", pool.UUID},
+{"Cluster", cluster.Name},
+{"Region", cluster.ZoneName},
+{"Size", pool.ComputeOfferingName},
+{"Nodes", fmt.Sprintf("%d", pool.Size)},
+}); err != nil {
+return err
+}
+
+if err := c.DeleteNodePool(pool.UUID); err != nil {
+return err
+}
+
+output.PrintSuccess(fmt.Sprintf("Node pool %s deleted successfully", pool.Name))
+return nil
+},
+}
+
+// parseLabels parses key=value node labels
+func parseLabels(pairs []string) (map[string]string, error) {
+if len(pairs) == 0 {
+return nil, nil
+}
+
+tags, err := parseTags(pairs)
+if err != nil {
+return nil, fmt.Errorf("invalid label: %w", err)
+}
+
+labels := make(map[string]string, len(tags))
+for _, t := range tags {
+labels[t.Key] = t.Value
+}
+return labels, nil
+}
+
+// taintEffects are the effects accepted by Kubernetes
+var taintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// parseTaints parses taints given as key=value:Effect or key:Effect
+func parseTaints(specs []string) ([]models.KubernetesTaint, error) {
+var taints []models.KubernetesTaint
+for _, spec := range specs {
+kv, effect, ok := strings.Cut(spec, ":")
+if !ok {
+return nil, fmt.Errorf("invalid taint '%s': expected key=value:Effect", spec)
+}
+
+valid := false
+for _, e := range taintEffects {
+if effect == e {
+valid = true
+break
+}
+}
+if !valid {
+return nil, fmt.Errorf("invalid taint effect '%s': must be one of %s", effect, strings.Join(taintEffects, ", "))
+}
+
+key, value, _ := strings.Cut(kv, "=")
+if key == "" {
+return nil, fmt.Errorf("invalid taint '%s': key is empty", spec)
+}
+
+taints = append(taints, models.KubernetesTaint{Key: key, Value: value, Effect: effect})
+}
+return taints, nil
+}
+
+// formatLabels renders node labels for table output
+func formatLabels(labels map[string]string) string {
+tags := make([]models.Tag, 0, len(labels))
+for k, v := range labels {
+tags = append(tags, models.Tag{Key: k, Value: v})
+}
+return formatTags(tags)
+}
+
+// formatTaints renders taints for table output
+func formatTaints(taints []models.KubernetesTaint) string {
+if len(taints) == 0 {
+return "-"
+}
+
+specs := make([]string, len(taints))
+for i, t := range taints {
+if t.Value != "" {
+specs[i] = fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect)
+} else {
+specs[i] = fmt.Sprintf("%s:%s", t.Key, t.Effect)
+}
+}
+return strings.Join(specs, ",")
+}
+
+// k8sWaitCmd waits for a cluster to become usable
+var k8sWaitCmd = &cobra.Command{
+Use:   "wait <name-or-uuid>",
+Short: "Wait until a Kubernetes cluster is ready",
+Long: `Wait until a Kubernetes cluster is Running and its control plane is usable.
+
+After the cluster reaches the Running state its kubeconfig is fetched, the
+API server /readyz endpoint is probed and nodes are counted until every
+expected node is Ready. A health summary is printed and the command exits
+non-zero if the cluster is not healthy before --timeout.`,
+Args: cobra.ExactArgs(1),
+RunE: func(cmd *cobra.Command, args []string) error {
+cfg, err := config.LoadConfig()
+if err != nil {
+return err
+}
+
+c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+timeout, _ := cmd.Flags().GetDuration("timeout")
+skipHealth, _ := cmd.Flags().GetBool("skip-health")
+
+cluster, err := c.FindKubernetesCluster(args[0])
+if err != nil {
+return err
+}
+
+return waitAndReportHealth(c, cluster, timeout, skipHealth)
+},
+}
+
+// clusterHealthReport is the health summary printed after waiting
+type clusterHealthReport struct {
+Cluster       string `json:"cluster" yaml:"cluster"`
+State         string `json:"state" yaml:"state"`
+ExpectedNodes int    `json:"expectedNodes" yaml:"expectedNodes"`
+k8s.Health    `yaml:",inline"`
+}
+
+// waitAndReportHealth waits for a cluster to be Running and, unless
+// skipHealth is set, for its API server and nodes to be Ready. It prints a
+// health summary and returns an error if the cluster is not healthy in time.
+func waitAndReportHealth(c *client.Client, cluster *models.KubernetesCluster, timeout time.Duration, skipHealth bool) error {
+deadline := time.Now().Add(timeout)
+
+cluster, err := waitForClusterState(c, cluster.UUID, "Running", timeout)
+if err != nil {
+return err
+}
+
+if skipHealth {
+output.PrintSuccess(fmt.Sprintf("Kubernetes cluster %s is Running", cluster.Name))
+return nil
+}
+
+report := &clusterHealthReport{
+Cluster:       cluster.Name,
+State:         cluster.State,
+ExpectedNodes: cluster.ControlNodes + cluster.Size,
+}
+
+var probeErr error
+for {
+var health *k8s.Health
+health, probeErr = probeClusterHealth(c, cluster.UUID)
+if health != nil {
+report.Health = *health
+}
+
+if probeErr == nil && report.APIServerReady && report.ReadyNodes >= report.ExpectedNodes {
+break
+}
+
+// Bad credentials will not fix themselves, so don't wait out the timeout
+if errors.Is(probeErr, k8s.ErrUnauthorized) {
+break
+}
+
+if time.Now().After(deadline) {
+break
+}
+
+status := fmt.Sprintf("%d/%d nodes Ready", report.ReadyNodes, report.ExpectedNodes)
+if probeErr != nil {
+status = probeErr.Error()
+} else if !report.APIServerReady {
+status = "API server not ready"
+}
+output.PrintInfo(fmt.Sprintf("Cluster %s: %s, waiting...", cluster.Name, status))
+time.Sleep(clusterPollInterval)
+}
+
+if err := printClusterHealth(report); err != nil {
+return err
+}
+
+if probeErr != nil {
+return fmt.Errorf("kubernetes cluster %s is not healthy: %w", cluster.Name, probeErr)
+}
+if !report.APIServerReady || report.ReadyNodes < report.ExpectedNodes {
+return fmt.Errorf("timed out after %s waiting for Kubernetes cluster %s to become healthy", timeout, cluster.Name)
+}
+
+output.PrintSuccess(fmt.Sprintf("Kubernetes cluster %s is healthy", cluster.Name))
+return nil
+}
+
+// probeClusterHealth fetches a cluster's kubeconfig and checks its API server and nodes
+func probeClusterHealth(c *client.Client, clusterUUID string) (*k8s.Health, error) {
+data, err := c.GetKubernetesConfig(clusterUUID)
+if err != nil {
+return nil, err
+}
+
+kcfg, err := kubeconfig.Parse([]byte(data))
+if err != nil {
+return nil, err
+}
+
+server, httpClient, err := kcfg.RESTClient(client.DefaultTimeout)
+if err != nil {
+return nil, err
+}
+
+return k8s.CheckHealth(server, httpClient)
+}
+
+// printClusterHealth prints a cluster health summary
+func printClusterHealth(report *clusterHealthReport) error {
+return output.Print(
+report,
+output.Format(outputFormat),
+[]string{"CLUSTER", "STATE", "API SERVER", "NODES READY", "NOT READY"},
+func(item interface{}) []string {
+r := item.(*clusterHealthReport)
+
+apiServer := "not ready"
+if r.APIServerReady {
+apiServer = "ready"
+} else if r.ReadyzStatus != "" {
+apiServer = r.ReadyzStatus
+}
+
+notReady := "-"
+if len(r.NotReadyNodes) > 0 {
+notReady = strings.Join(r.NotReadyNodes, ",")
+}
+
+return []string{
+r.Cluster,
+r.State,
+apiServer,
+fmt.Sprintf("%d/%d", r.ReadyNodes, r.ExpectedNodes),
+notReady,
+}
+},
+)
+}
+
+// clusterPollInterval is how often cluster state is checked while waiting
+const clusterPollInterval = 10 * time.Second
+
+// finishClusterOperation reports a submitted cluster operation, waiting for
+// the operation to take effect first when --wait is set
+func finishClusterOperation(cmd *cobra.Command, c *client.Client, cluster *models.KubernetesCluster, target clusterTarget, message string) error {
+wait, _ := cmd.Flags().GetBool("wait")
+if !wait {
+output.PrintSuccess(message)
+return nil
+}
+
+timeout, _ := cmd.Flags().GetDuration("timeout")
+if _, err := waitForCluster(c, cluster.UUID, target, timeout); err != nil {
+return err
+}
+
+output.PrintSuccess(message)
+return nil
+}
+
+// clusterTarget describes the cluster condition an operation waits for
+type clusterTarget struct {
+Description string
+Reached     func(cluster *models.KubernetesCluster) bool
+}
+
+// clusterInState is reached once the cluster is in the given state
+func clusterInState(want string) clusterTarget {
+return clusterTarget{
+Description: want,
+Reached: func(cluster *models.KubernetesCluster) bool {
+return strings.EqualFold(cluster.State, want)
+},
+}
+}
+
+// clusterScaledTo is reached once the cluster is Running with the given
+// number of worker nodes. The cluster is already Running when a scale is
+// submitted, so the state alone does not show that the scale happened.
+func clusterScaledTo(size int) clusterTarget {
+return clusterTarget{
+Description: fmt.Sprintf("Running with %d worker nodes", size),
+Reached: func(cluster *models.KubernetesCluster) bool {
+return strings.EqualFold(cluster.State, "Running") && cluster.Size == size
+},
+}
+}
+
+// clusterAtVersion is reached once the cluster is Running the given
+// Kubernetes version
+func clusterAtVersion(version string) clusterTarget {
+return clusterTarget{
+Description: fmt.Sprintf("Running version %s", version),
+Reached: func(cluster *models.KubernetesCluster) bool {
+return strings.EqualFold(cluster.State, "Running") && compareVersions(cluster.KubernetesVersion, version) == 0
+},
+}
+}
+
+// waitForClusterState polls a cluster until it reaches the wanted state,
+// enters an error state, or the timeout expires
+func waitForClusterState(c *client.Client, clusterUUID, want string, timeout time.Duration) (*models.KubernetesCluster, error) {
+return waitForCluster(c, clusterUUID, clusterInState(want), timeout)
+}
+
+// waitForCluster polls a cluster until the target is reached, the cluster
+// enters an error state, or the timeout expires
+func waitForCluster(c *client.Client, clusterUUID string, target clusterTarget, timeout time.Duration) (*models.KubernetesCluster, error) {
+deadline := time.Now().Add(timeout)
+
+for {
+clusters, err := c.ListKubernetesClusters(clusterUUID)
+if err != nil {
+return nil, fmt.Errorf("failed to get Kubernetes cluster state: %w", err)
+}
+
+var cluster *models.KubernetesCluster
+for i := range clusters {
+if clusters[i].UUID == clusterUUID {
+cluster = &clusters[i]
+break
+}
+}
+if cluster == nil {
+return nil, fmt.Errorf("kubernetes cluster not found: %s", clusterUUID)
+}
+
+if target.Reached(cluster) {
+return cluster, nil
+}
+
+if strings.EqualFold(cluster.State, "Error") || strings.EqualFold(cluster.State, "Alert") {
+return cluster, fmt.Errorf("kubernetes cluster %s entered state %s while waiting for %s", cluster.Name, cluster.State, target.Description)
+}
+
+if time.Now().After(deadline) {
+return cluster, fmt.Errorf("timed out after %s waiting for Kubernetes cluster %s to become %s (current state: %s)", timeout, cluster.Name, target.Description, cluster.State)
+}
+
+output.PrintInfo(fmt.Sprintf("Cluster %s is %s, waiting for %s...", cluster.Name, cluster.State, target.Description))
+time.Sleep(clusterPollInterval)
+}
+}
+
+// compareVersions compares dotted numeric versions such as 1.28.4. A leading
+// "v" and any non-numeric suffix are ignored. It returns -1, 0 or 1.
+func compareVersions(a, b string) int {
+pa, pb := versionParts(a), versionParts(b)
+for i := 0; i < len(pa) || i < len(pb); i++ {
+var x, y int
+if i < len(pa) {
+x = pa[i]
+}
+if i < len(pb) {
+y = pb[i]
+}
+if x != y {
+if x < y {
+return -1
+}
+return 1
+}
+}
+return 0
+}
+
+// versionParts extracts the numeric components of a version string
+func versionParts(v string) []int {
+v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+
+var parts []int
+for _, field := range strings.Split(v, ".") {
+end := 0
+for end < len(field) && field[end] >= '0' && field[end] <= '9' {
+end++
+}
+if end == 0 {
+break
+}
+n, _ := strconv.Atoi(field[:end])
+parts = append(parts, n)
+if end < len(field) {
+break
+}
+}
+return parts
+}
+
+// checkOfferingMeetsVersion verifies a compute offering satisfies the
+// minimum CPU and memory required by a Kubernetes version
+func checkOfferingMeetsVersion(off *models.ComputeOffering, version *models.KubernetesVersion) error {
+cores, err := strconv.ParseInt(off.NumberOfCores, 10, 64)
+if err != nil {
+return fmt.Errorf("compute size '%s' has an invalid CPU count: %s", off.Name, off.NumberOfCores)
+}
+
+memory, err := strconv.ParseInt(off.Memory, 10, 64)
+if err != nil {
+return fmt.Errorf("compute size '%s' has an invalid memory size: %s", off.Name, off.Memory)
+}
+
+if cores < version.MinCPUNumber || memory < version.MinMemory {
+return fmt.Errorf(
+"compute size '%s' (%d CPU, %d MB) does not meet the minimum for Kubernetes %s (%d CPU, %d MB)",
+off.Name, cores, memory, version.Name, version.MinCPUNumber, version.MinMemory,
+)
+}
+
+return nil
+}
+
 func init() {
 rootCmd.AddCommand(k8sCmd)
 k8sCmd.AddCommand(k8sVersionsCmd)
+k8sCmd.AddCommand(k8sListCmd)
+k8sCmd.AddCommand(k8sGetCmd)
+k8sCmd.AddCommand(k8sCreateCmd)
+k8sCmd.AddCommand(k8sDeleteCmd)
+k8sCmd.AddCommand(k8sKubeconfigCmd)
+k8sCmd.AddCommand(k8sScaleCmd)
+k8sCmd.AddCommand(k8sStartCmd)
+k8sCmd.AddCommand(k8sStopCmd)
+k8sCmd.AddCommand(k8sUpgradeCmd)
+k8sCmd.AddCommand(k8sNodesCmd)
+k8sCmd.AddCommand(k8sWaitCmd)
+k8sCmd.AddCommand(k8sNodePoolCmd)
+k8sNodePoolCmd.AddCommand(k8sNodePoolListCmd)
+k8sNodePoolCmd.AddCommand(k8sNodePoolAddCmd)
+k8sNodePoolCmd.AddCommand(k8sNodePoolScaleCmd)
+k8sNodePoolCmd.AddCommand(k8sNodePoolDeleteCmd)
+
+k8sListCmd.Flags().String("selector", "", "Filter by tags, e.g. env=prod,team=web")
+
+k8sCreateCmd.Flags().String("name", "", "Cluster name (required)")
+k8sCreateCmd.Flags().String("description", "", "Cluster description")
+k8sCreateCmd.Flags().String("version", "", "Kubernetes version name or UUID (required)")
+k8sCreateCmd.Flags().String("node-size", "", "Compute size name or UUID for the nodes (required)")
+k8sCreateCmd.Flags().String("network", "", "Network name or UUID (required)")
+k8sCreateCmd.Flags().String("ssh-key", "", "SSH key name")
+k8sCreateCmd.Flags().Int("size", 1, "Number of worker nodes")
+k8sCreateCmd.Flags().Int("control-nodes", 1, "Number of control plane nodes (3 by default with --ha)")
+k8sCreateCmd.Flags().Bool("ha", false, "Enable a highly available control plane")
+k8sCreateCmd.Flags().Int64("node-disk", 0, "Node root disk size in GB (uses the image default if not specified)")
+k8sCreateCmd.Flags().StringArray("tag", nil, "Tag as key=value (repeatable)")
+k8sCreateCmd.Flags().Bool("estimate", false, "Print the estimated monthly cost before creating")
+k8sCreateCmd.Flags().Bool("wait", false, "Wait until the cluster is running and healthy")
+k8sCreateCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait with --wait")
+
+k8sWaitCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait")
+k8sWaitCmd.Flags().Bool("skip-health", false, "Only wait for the Running state, without probing the API server")
+
+k8sKubeconfigCmd.Flags().Bool("merge", false, "Merge into the local kubeconfig instead of printing")
+k8sKubeconfigCmd.Flags().Bool("force", false, "Overwrite existing entries with the same name when merging")
+k8sKubeconfigCmd.Flags().Bool("switch-context", false, "Make the merged context the current context")
+k8sKubeconfigCmd.Flags().String("kubeconfig", "", "Kubeconfig file to merge into (default $KUBECONFIG or ~/.kube/config)")
+
+k8sScaleCmd.Flags().Int("size", 0, "Number of worker nodes (required)")
+k8sUpgradeCmd.Flags().String("version", "", "Target Kubernetes version name or UUID (required)")
+
+for _, c := range []*cobra.Command{k8sScaleCmd, k8sStartCmd, k8sStopCmd, k8sUpgradeCmd} {
+c.Flags().Bool("wait", false, "Wait until the operation completes")
+c.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait with --wait")
+}
+
+k8sNodePoolAddCmd.Flags().String("name", "", "Node pool name (required)")
+k8sNodePoolAddCmd.Flags().String("node-size", "", "Compute size name or UUID for the nodes (required)")
+k8sNodePoolAddCmd.Flags().Int("count", 1, "Number of nodes")
+k8sNodePoolAddCmd.Flags().Int64("node-disk", 0, "Node root disk size in GB (uses the image default if not specified)")
+k8sNodePoolAddCmd.Flags().StringArray("label", nil, "Node label as key=value (repeatable)")
+k8sNodePoolAddCmd.Flags().StringArray("taint", nil, "Node taint as key=value:Effect (repeatable)")
+
+k8sNodePoolScaleCmd.Flags().Int("count", 0, "Number of nodes (required)")
 }
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+func TestVersionParts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []int
+	}{
+		{"1.28.4", []int{1, 28, 4}},
+		{"v1.29.0", []int{1, 29, 0}},
+		{" 1.27 ", []int{1, 27}},
+		{"1.28.4-sannti.1", []int{1, 28, 4}},
+		{"1.30.0-rc1", []int{1, 30, 0}},
+		{"1.x.2", []int{1}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		if got := versionParts(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("versionParts(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.28.4", "1.28.4", 0},
+		{"v1.28.4", "1.28.4", 0},
+		{"1.28", "1.28.0", 0},
+		{"1.28.4", "1.28.10", -1},
+		{"1.29.0", "1.28.10", 1},
+		{"1.30.0", "1.9.9", 1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.28.4-sannti.1", "1.28.4", 0},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClusterTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  clusterTarget
+		cluster models.KubernetesCluster
+		want    bool
+	}{
+		{"state matches", clusterInState("Running"), models.KubernetesCluster{State: "running"}, true},
+		{"state differs", clusterInState("Stopped"), models.KubernetesCluster{State: "Running"}, false},
+
+		{"scale not started", clusterScaledTo(5), models.KubernetesCluster{State: "Running", Size: 3}, false},
+		{"scale in progress", clusterScaledTo(5), models.KubernetesCluster{State: "Scaling", Size: 5}, false},
+		{"scale done", clusterScaledTo(5), models.KubernetesCluster{State: "Running", Size: 5}, true},
+
+		{"upgrade not started", clusterAtVersion("1.29.0"), models.KubernetesCluster{State: "Running", KubernetesVersion: "1.28.4"}, false},
+		{"upgrade in progress", clusterAtVersion("1.29.0"), models.KubernetesCluster{State: "Upgrading", KubernetesVersion: "1.29.0"}, false},
+		{"upgrade done", clusterAtVersion("1.29.0"), models.KubernetesCluster{State: "Running", KubernetesVersion: "v1.29.0"}, true},
+	}
+
+	for _, tt := range tests {
+		cluster := tt.cluster
+		if got := tt.target.Reached(&cluster); got != tt.want {
+			t.Errorf("%s: Reached() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,640 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// lbCmd represents the lb command
+var lbCmd = &cobra.Command{
+	Use:     "lb",
+	Aliases: []string{"loadbalancer"},
+	Short:   "Manage load balancers",
+	Long:    `List and manage load balancers that spread traffic on a public IP across instances.`,
+}
+
+// lbListCmd lists load balancers
+var lbListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List load balancers",
+	Long:  `List all load balancers in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		rules, err := c.ListLoadBalancers(region)
+		if err != nil {
+			return fmt.Errorf("failed to list load balancers: %w", err)
+		}
+
+		if len(rules) == 0 {
+			output.PrintInfo("No load balancers found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(rules))
+		for i, rule := range rules {
+			dataSlice[i] = rule
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "IP ADDRESS", "PROTOCOL", "PORTS", "ALGORITHM", "STATE"},
+			func(item interface{}) []string {
+				lb := item.(models.LoadBalancerRule)
+				return []string{lb.UUID, lb.Name, valueOr(lb.IPAddress, "-"), lb.Protocol, lb.PublicPort + "->" + lb.PrivatePort, lb.Algorithm, lb.State}
+			},
+		)
+	},
+}
+
+// lbMemberStatus is a load balancer member with the state of its instance
+type lbMemberStatus struct {
+	models.LoadBalancerMember `yaml:",inline"`
+	InstanceState             string `json:"instanceState" yaml:"instanceState"`
+}
+
+// lbDetails is the machine-readable output of lb get
+type lbDetails struct {
+	*models.LoadBalancerRule `yaml:",inline"`
+	Members                  []lbMemberStatus `json:"members" yaml:"members"`
+}
+
+// lbGetCmd shows a load balancer and its members
+var lbGetCmd = &cobra.Command{
+	Use:   "get <name-or-uuid>",
+	Short: "Get load balancer details",
+	Long:  `Show a load balancer, its settings and its members with their instance states.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		lb, err := c.FindLoadBalancer(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		members, err := c.ListLoadBalancerMembers(lb.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list load balancer members: %w", err)
+		}
+
+		instances, err := c.ListInstances(region)
+		if err != nil {
+			return fmt.Errorf("failed to list instances: %w", err)
+		}
+		states := make(map[string]string, len(instances))
+		for _, inst := range instances {
+			states[inst.UUID] = inst.State
+		}
+
+		details := lbDetails{LoadBalancerRule: lb}
+		for _, m := range members {
+			details.Members = append(details.Members, lbMemberStatus{
+				LoadBalancerMember: m,
+				InstanceState:      valueOr(states[m.UUID], "Unknown"),
+			})
+		}
+
+		if output.Format(outputFormat) != output.FormatTable {
+			return output.Print(details, output.Format(outputFormat), nil, nil)
+		}
+
+		if err := output.Print(
+			lb,
+			output.FormatTable,
+			[]string{"UUID", "NAME", "IP ADDRESS", "PROTOCOL", "PORTS", "ALGORITHM", "STICKY", "HEALTH CHECK", "STATE"},
+			func(item interface{}) []string {
+				lb := item.(*models.LoadBalancerRule)
+				return []string{
+					lb.UUID, lb.Name, valueOr(lb.IPAddress, "-"), lb.Protocol, lb.PublicPort + "->" + lb.PrivatePort,
+					lb.Algorithm, valueOr(lb.StickyMethod, "-"), valueOr(lb.HealthCheckPath, "-"), lb.State,
+				}
+			},
+		); err != nil {
+			return err
+		}
+
+		fmt.Println()
+		if len(details.Members) == 0 {
+			output.PrintInfo("No members, add instances with 'sannti lb add-member'")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(details.Members))
+		for i, m := range details.Members {
+			dataSlice[i] = m
+		}
+
+		return output.Print(
+			dataSlice,
+			output.FormatTable,
+			[]string{"MEMBER UUID", "NAME", "PRIVATE IP", "LB STATE", "INSTANCE STATE"},
+			func(item interface{}) []string {
+				m := item.(lbMemberStatus)
+				return []string{m.UUID, valueOr(m.Name, "-"), valueOr(m.PrivateIP, "-"), valueOr(m.State, "-"), m.InstanceState}
+			},
+		)
+	},
+}
+
+// lbCreateCmd creates a load balancer
+var lbCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a load balancer",
+	Long: `Create a load balancer on a public IP address.
+
+Algorithms: roundrobin, leastconn, source.
+Sticky sessions (--sticky): lb-cookie, app-cookie, source.
+A health check is configured when --health-check-path is given.
+
+Example:
+  sannti lb create --name web --ip 203.0.113.10 --public-port 80 --private-port 8080 \
+    --instance web-1 --instance web-2 --health-check-path /healthz`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		ipRef, _ := cmd.Flags().GetString("ip")
+		protocol, _ := cmd.Flags().GetString("protocol")
+		publicPort, _ := cmd.Flags().GetInt("public-port")
+		privatePort, _ := cmd.Flags().GetInt("private-port")
+		algorithm, _ := cmd.Flags().GetString("algorithm")
+		instanceRefs, _ := cmd.Flags().GetStringArray("instance")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || ipRef == "" || publicPort == 0 {
+			return fmt.Errorf("required flags: --name, --ip, --public-port")
+		}
+		if privatePort == 0 {
+			privatePort = publicPort
+		}
+		for _, port := range []int{publicPort, privatePort} {
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("invalid port %d: ports must be between 1 and 65535", port)
+			}
+		}
+
+		protocol = strings.ToLower(protocol)
+		if protocol != "tcp" && protocol != "udp" {
+			return fmt.Errorf("invalid protocol '%s': must be tcp or udp", protocol)
+		}
+		if err := validateLBAlgorithm(algorithm); err != nil {
+			return err
+		}
+		if err := validateLBPolicyFlags(cmd); err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		ip, err := c.FindIPAddress(ipRef, region)
+		if err != nil {
+			return err
+		}
+		if hasStaticNat(*ip) {
+			return fmt.Errorf("%s has static NAT to instance %s and cannot be load balanced", ip.IpAddress, valueOr(ip.VirtualMachineName, ip.VirtualMachineUUID))
+		}
+
+		instances, err := findInstances(c, instanceRefs, region)
+		if err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating load balancer '%s' on %s:%d...", name, ip.IpAddress, publicPort))
+
+		lb, err := c.CreateLoadBalancer(models.CreateLoadBalancerRequest{
+			Name:          name,
+			Description:   description,
+			IPAddressUUID: ip.UUID,
+			Protocol:      protocol,
+			PublicPort:    publicPort,
+			PrivatePort:   privatePort,
+			Algorithm:     algorithm,
+			Region:        region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create load balancer: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Load balancer created: %s (UUID: %s)", lb.Name, lb.UUID))
+
+		if err := applyLBPolicies(cmd, c, lb); err != nil {
+			return err
+		}
+
+		if len(instances) > 0 {
+			if err := c.AddLoadBalancerMembers(lb.UUID, instanceUUIDs(instances)); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Added %s", joinInstanceNames(instances)))
+		}
+
+		return nil
+	},
+}
+
+// lbUpdateCmd changes load balancer settings
+var lbUpdateCmd = &cobra.Command{
+	Use:   "update <name-or-uuid>",
+	Short: "Update load balancer settings",
+	Long: `Change the name, algorithm, sticky sessions or health check of a load balancer.
+
+Use --sticky none to turn sticky sessions off.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		algorithm, _ := cmd.Flags().GetString("algorithm")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if algorithm != "" {
+			if err := validateLBAlgorithm(algorithm); err != nil {
+				return err
+			}
+		}
+		if err := validateLBPolicyFlags(cmd); err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		lb, err := c.FindLoadBalancer(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		changed := false
+		if name != "" || description != "" || algorithm != "" {
+			if err := c.UpdateLoadBalancer(models.UpdateLoadBalancerRequest{
+				UUID:        lb.UUID,
+				Name:        name,
+				Description: description,
+				Algorithm:   algorithm,
+			}); err != nil {
+				return err
+			}
+			changed = true
+		}
+
+		if cmd.Flags().Changed("sticky") || lbHealthCheckChanged(cmd) {
+			if err := applyLBPolicies(cmd, c, lb); err != nil {
+				return err
+			}
+			changed = true
+		}
+
+		if !changed {
+			return fmt.Errorf("nothing to update: pass --name, --description, --algorithm, --sticky or --health-check-* flags")
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Load balancer %s updated successfully", lb.Name))
+		return nil
+	},
+}
+
+// lbDeleteCmd deletes a load balancer
+var lbDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a load balancer",
+	Long: `Delete a load balancer. Member instances are not affected.
+
+You will be asked to type the load balancer name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		lb, err := c.FindLoadBalancer(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructive("load balancer", lb.Name, []resourceDetail{
+			{"Name", lb.Name},
+			{"UUID", lb.UUID},
+			{"IP address", lb.IPAddress},
+			{"Ports", lb.PublicPort + "->" + lb.PrivatePort},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteLoadBalancer(lb.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Load balancer %s deleted successfully", lb.Name))
+		return nil
+	},
+}
+
+// lbAddMemberCmd adds instances to a load balancer
+var lbAddMemberCmd = &cobra.Command{
+	Use:   "add-member <name-or-uuid>",
+	Short: "Add instances to a load balancer",
+	Long:  `Add one or more instances to a load balancer.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeLBMembers(cmd, args[0], true)
+	},
+}
+
+// lbRemoveMemberCmd removes instances from a load balancer
+var lbRemoveMemberCmd = &cobra.Command{
+	Use:   "remove-member <name-or-uuid>",
+	Short: "Remove instances from a load balancer",
+	Long:  `Remove one or more instances from a load balancer. The instances keep running.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeLBMembers(cmd, args[0], false)
+	},
+}
+
+// changeLBMembers adds or removes the --instance members of a load balancer
+func changeLBMembers(cmd *cobra.Command, lbRef string, add bool) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	instanceRefs, _ := cmd.Flags().GetStringArray("instance")
+	if len(instanceRefs) == 0 {
+		return fmt.Errorf("required flag: --instance")
+	}
+
+	c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+	region := regionFlag
+	if region == "" {
+		region = cfg.DefaultRegion
+	}
+
+	lb, err := c.FindLoadBalancer(lbRef, region)
+	if err != nil {
+		return err
+	}
+
+	instances, err := findInstances(c, instanceRefs, region)
+	if err != nil {
+		return err
+	}
+
+	members, err := c.ListLoadBalancerMembers(lb.UUID)
+	if err != nil {
+		return fmt.Errorf("failed to list load balancer members: %w", err)
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UUID] = true
+	}
+
+	for _, inst := range instances {
+		if add && isMember[inst.UUID] {
+			return fmt.Errorf("instance %s is already a member of %s", inst.Name, lb.Name)
+		}
+		if !add && !isMember[inst.UUID] {
+			return fmt.Errorf("instance %s is not a member of %s", inst.Name, lb.Name)
+		}
+	}
+
+	if add {
+		if err := c.AddLoadBalancerMembers(lb.UUID, instanceUUIDs(instances)); err != nil {
+			return err
+		}
+		output.PrintSuccess(fmt.Sprintf("Added %s to %s", joinInstanceNames(instances), lb.Name))
+		return nil
+	}
+
+	if err := c.RemoveLoadBalancerMembers(lb.UUID, instanceUUIDs(instances)); err != nil {
+		return err
+	}
+	output.PrintSuccess(fmt.Sprintf("Removed %s from %s", joinInstanceNames(instances), lb.Name))
+	return nil
+}
+
+// lbAlgorithms are the balancing algorithms supported by the platform
+var lbAlgorithms = []string{"roundrobin", "leastconn", "source"}
+
+// lbStickyMethods maps --sticky values to platform stickiness methods
+var lbStickyMethods = map[string]string{
+	"lb-cookie":  "LbCookie",
+	"app-cookie": "AppCookie",
+	"source":     "SourceBased",
+}
+
+// validateLBAlgorithm checks an algorithm name
+func validateLBAlgorithm(algorithm string) error {
+	for _, a := range lbAlgorithms {
+		if a == algorithm {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid algorithm '%s': must be one of %s", algorithm, strings.Join(lbAlgorithms, ", "))
+}
+
+// validateLBPolicyFlags checks the sticky session and health check flags
+func validateLBPolicyFlags(cmd *cobra.Command) error {
+	sticky, _ := cmd.Flags().GetString("sticky")
+	if _, ok := lbStickyMethods[sticky]; sticky != "" && sticky != "none" && !ok {
+		return fmt.Errorf("invalid sticky method '%s': must be one of lb-cookie, app-cookie, source or none", sticky)
+	}
+
+	path, _ := cmd.Flags().GetString("health-check-path")
+	if path != "" && !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("invalid health check path '%s': must start with /", path)
+	}
+	if path == "" && lbHealthCheckChanged(cmd) {
+		return fmt.Errorf("--health-check-path is required when configuring a health check")
+	}
+
+	for _, name := range []string{"health-check-interval", "health-check-timeout", "healthy-threshold", "unhealthy-threshold"} {
+		if v, _ := cmd.Flags().GetInt(name); v < 1 {
+			return fmt.Errorf("--%s must be at least 1", name)
+		}
+	}
+
+	interval, _ := cmd.Flags().GetInt("health-check-interval")
+	timeout, _ := cmd.Flags().GetInt("health-check-timeout")
+	if timeout >= interval {
+		return fmt.Errorf("--health-check-timeout (%ds) must be shorter than --health-check-interval (%ds)", timeout, interval)
+	}
+
+	return nil
+}
+
+// lbHealthCheckChanged reports whether any health check flag was set
+func lbHealthCheckChanged(cmd *cobra.Command) bool {
+	for _, name := range []string{"health-check-path", "health-check-interval", "health-check-timeout", "healthy-threshold", "unhealthy-threshold"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyLBPolicies applies the sticky session and health check flags to a load balancer
+func applyLBPolicies(cmd *cobra.Command, c *client.Client, lb *models.LoadBalancerRule) error {
+	sticky, _ := cmd.Flags().GetString("sticky")
+	switch {
+	case sticky == "none":
+		if err := c.DeleteStickinessPolicy(lb.UUID); err != nil {
+			return err
+		}
+		output.PrintSuccess("Sticky sessions disabled")
+	case sticky != "":
+		if err := c.SetStickinessPolicy(models.StickinessPolicyRequest{
+			LoadBalancerUUID: lb.UUID,
+			Name:             lb.Name + "-sticky",
+			Method:           lbStickyMethods[sticky],
+		}); err != nil {
+			return err
+		}
+		output.PrintSuccess(fmt.Sprintf("Sticky sessions enabled (%s)", sticky))
+	}
+
+	path, _ := cmd.Flags().GetString("health-check-path")
+	if path == "" {
+		return nil
+	}
+
+	interval, _ := cmd.Flags().GetInt("health-check-interval")
+	timeout, _ := cmd.Flags().GetInt("health-check-timeout")
+	healthy, _ := cmd.Flags().GetInt("healthy-threshold")
+	unhealthy, _ := cmd.Flags().GetInt("unhealthy-threshold")
+
+	if err := c.SetHealthCheckPolicy(models.HealthCheckPolicyRequest{
+		LoadBalancerUUID:   lb.UUID,
+		PingPath:           path,
+		Interval:           interval,
+		ResponseTimeout:    timeout,
+		HealthyThreshold:   healthy,
+		UnhealthyThreshold: unhealthy,
+	}); err != nil {
+		return err
+	}
+	output.PrintSuccess(fmt.Sprintf("Health check set to %s every %ds", path, interval))
+
+	return nil
+}
+
+// findInstances resolves a list of instance names or UUIDs
+func findInstances(c *client.Client, refs []string, region string) ([]models.Instance, error) {
+	var instances []models.Instance
+	for _, ref := range refs {
+		inst, err := c.FindInstance(ref, region)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, *inst)
+	}
+	return instances, nil
+}
+
+// instanceUUIDs returns the UUIDs of instances
+func instanceUUIDs(instances []models.Instance) []string {
+	uuids := make([]string, len(instances))
+	for i, inst := range instances {
+		uuids[i] = inst.UUID
+	}
+	return uuids
+}
+
+// joinInstanceNames returns the names of instances as a comma-separated list
+func joinInstanceNames(instances []models.Instance) string {
+	names := make([]string, len(instances))
+	for i, inst := range instances {
+		names[i] = inst.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// addLBPolicyFlags registers the sticky session and health check flags
+func addLBPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String("sticky", "", "Sticky sessions: lb-cookie, app-cookie, source or none")
+	cmd.Flags().String("health-check-path", "", "HTTP path probed on members, e.g. /healthz")
+	cmd.Flags().Int("health-check-interval", 5, "Seconds between health checks")
+	cmd.Flags().Int("health-check-timeout", 2, "Seconds to wait for a health check response")
+	cmd.Flags().Int("healthy-threshold", 2, "Successful checks before a member is marked healthy")
+	cmd.Flags().Int("unhealthy-threshold", 5, "Failed checks before a member is marked unhealthy")
+}
+
+func init() {
+	rootCmd.AddCommand(lbCmd)
+	lbCmd.AddCommand(lbListCmd)
+	lbCmd.AddCommand(lbGetCmd)
+	lbCmd.AddCommand(lbCreateCmd)
+	lbCmd.AddCommand(lbUpdateCmd)
+	lbCmd.AddCommand(lbDeleteCmd)
+	lbCmd.AddCommand(lbAddMemberCmd)
+	lbCmd.AddCommand(lbRemoveMemberCmd)
+
+	lbCreateCmd.Flags().String("name", "", "Load balancer name (required)")
+	lbCreateCmd.Flags().String("description", "", "Load balancer description")
+	lbCreateCmd.Flags().String("ip", "", "Public IP address or IP UUID to listen on (required)")
+	lbCreateCmd.Flags().String("protocol", "tcp", "Protocol: tcp or udp")
+	lbCreateCmd.Flags().Int("public-port", 0, "Port to listen on (required)")
+	lbCreateCmd.Flags().Int("private-port", 0, "Port on the members (default: same as public)")
+	lbCreateCmd.Flags().String("algorithm", "roundrobin", "Algorithm: roundrobin, leastconn or source")
+	lbCreateCmd.Flags().StringArray("instance", nil, "Instance to add as a member, name or UUID (repeatable)")
+	addLBPolicyFlags(lbCreateCmd)
+
+	lbUpdateCmd.Flags().String("name", "", "New load balancer name")
+	lbUpdateCmd.Flags().String("description", "", "New load balancer description")
+	lbUpdateCmd.Flags().String("algorithm", "", "Algorithm: roundrobin, leastconn or source")
+	addLBPolicyFlags(lbUpdateCmd)
+
+	lbAddMemberCmd.Flags().StringArray("instance", nil, "Instance to add, name or UUID (repeatable)")
+	lbRemoveMemberCmd.Flags().StringArray("instance", nil, "Instance to remove, name or UUID (repeatable)")
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/spf13/cobra"
 	"github.com/sannticloud/sannti-cli/internal/client"
@@ -21,7 +22,7 @@ var networkCmd = &cobra.Command{
 var networkListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List networks",
-	Long:  `List all networks, optionally filtered by region.`,
+	Long:  `List all networks, optionally filtered by region and tag selector.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -30,6 +31,12 @@ var networkListCmd = &cobra.Command{
 
 		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
 
+		selectorFlag, _ := cmd.Flags().GetString("selector")
+		selector, err := parseSelector(selectorFlag)
+		if err != nil {
+			return err
+		}
+
 		// Use region flag or default
 		region := regionFlag
 		if region == "" {
@@ -41,6 +48,14 @@ var networkListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list networks: %w", err)
 		}
 
+		var matched []models.Network
+		for _, net := range networks {
+			if matchesSelector(net.Tags, selector) {
+				matched = append(matched, net)
+			}
+		}
+		networks = matched
+
 		if len(networks) == 0 {
 			output.PrintInfo("No networks found")
 			return nil
@@ -55,16 +70,381 @@ var networkListCmd = &cobra.Command{
 		return output.Print(
 			dataSlice,
 			output.Format(outputFormat),
-			[]string{"UUID", "NAME", "STATE", "REGION", "CIDR"},
+			[]string{"UUID", "NAME", "STATE", "REGION", "CIDR", "VPC", "TAGS"},
 			func(item interface{}) []string {
 				net := item.(models.Network)
-				return []string{net.UUID, net.Name, net.State, net.ZoneName, net.Cidr}
+				return []string{net.UUID, net.Name, net.State, net.ZoneName, net.Cidr, valueOr(net.VpcName, "-"), formatTags(net.Tags)}
+			},
+		)
+	},
+}
+
+// networkGetCmd gets a specific network
+var networkGetCmd = &cobra.Command{
+	Use:   "get <name-or-uuid>",
+	Short: "Get network details",
+	Long:  `Get detailed information about a specific network.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		network, err := c.FindNetwork(args[0], region)
+		if err != nil {
+			return fmt.Errorf("failed to get network: %w", err)
+		}
+
+		return output.Print(
+			network,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "STATE", "TYPE", "CIDR", "GATEWAY", "NETMASK", "DOMAIN", "OFFERING"},
+			func(item interface{}) []string {
+				net := item.(*models.Network)
+				row := []string{
+					net.UUID, net.Name, net.State, net.Type, net.Cidr,
+					net.Gateway, net.Netmask, net.NetworkDomain, net.NetworkOfferingName,
+				}
+				for i, v := range row {
+					if v == "" {
+						row[i] = "-"
+					}
+				}
+				return row
+			},
+		)
+	},
+}
+
+// networkCreateCmd creates a new network
+var networkCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a network",
+	Long: `Create a new network.
+
+The CIDR must not overlap any existing network in the region. The gateway
+defaults to the first usable address of the CIDR. --offering accepts a
+name or UUID; run 'sannti network offerings' to list them.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		cidr, _ := cmd.Flags().GetString("cidr")
+		gateway, _ := cmd.Flags().GetString("gateway")
+		offeringRef, _ := cmd.Flags().GetString("offering")
+		tagPairs, _ := cmd.Flags().GetStringArray("tag")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || cidr == "" || offeringRef == "" {
+			return fmt.Errorf("required flags: --name, --cidr, --offering")
+		}
+
+		tags, err := parseTags(tagPairs)
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		existing, err := c.ListNetworks(region)
+		if err != nil {
+			return fmt.Errorf("failed to list networks: %w", err)
+		}
+
+		ipNet, gateway, err := validateNetworkCIDR(cidr, gateway, existing)
+		if err != nil {
+			return err
+		}
+
+		offering, err := c.FindNetworkOffering(offeringRef, region)
+		if err != nil {
+			return err
+		}
+
+		if description == "" {
+			description = name
+		}
+
+		req := models.CreateNetworkRequest{
+			Name:                name,
+			DisplayText:         description,
+			Region:              region,
+			NetworkOfferingUUID: offering.UUID,
+			Cidr:                ipNet.String(),
+			Gateway:             gateway,
+			Netmask:             net.IP(ipNet.Mask).String(),
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating network '%s' (%s) in region '%s'...", name, ipNet, region))
+
+		network, err := c.CreateNetwork(req)
+		if err != nil {
+			return fmt.Errorf("failed to create network: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network created: %s (UUID: %s)", network.Name, network.UUID))
+
+		if len(tags) > 0 {
+			if err := c.CreateTags("network", network.UUID, tags); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Tagged network with %s", formatTags(tags)))
+		}
+
+		return nil
+	},
+}
+
+// networkUpdateCmd updates a network
+var networkUpdateCmd = &cobra.Command{
+	Use:   "update <name-or-uuid>",
+	Short: "Update a network",
+	Long: `Rename a network, change its description or move it to another network
+offering. Changing the offering may briefly interrupt traffic on the network.
+
+The CIDR and gateway of a network cannot be changed; create a new network
+instead.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		offeringRef, _ := cmd.Flags().GetString("offering")
+
+		if name == "" && description == "" && offeringRef == "" {
+			return fmt.Errorf("nothing to update: pass --name, --description and/or --offering")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		network, err := c.FindNetwork(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		req := models.UpdateNetworkRequest{
+			UUID:        network.UUID,
+			Name:        name,
+			DisplayText: description,
+		}
+
+		if offeringRef != "" {
+			offering, err := c.FindNetworkOffering(offeringRef, region)
+			if err != nil {
+				return err
+			}
+			if offering.UUID == network.NetworkOfferingUUID {
+				return fmt.Errorf("network %s already uses offering %s", network.Name, offering.Name)
+			}
+			req.NetworkOfferingUUID = offering.UUID
+			output.PrintInfo(fmt.Sprintf("Changing offering of network %s from %s to %s...", network.Name, valueOr(network.NetworkOfferingName, "-"), offering.Name))
+		}
+
+		updated, err := c.UpdateNetwork(req)
+		if err != nil {
+			return fmt.Errorf("failed to update network: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network %s updated successfully", updated.Name))
+		return nil
+	},
+}
+
+// networkDeleteCmd deletes a network
+var networkDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a network",
+	Long: `Delete a network. The network must not have any instances attached.
+
+You will be asked to type the network name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		network, err := c.FindNetwork(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructive("network", network.Name, []resourceDetail{
+			{"Name", network.Name},
+			{"UUID", network.UUID},
+			{"Region", network.ZoneName},
+			{"CIDR", network.Cidr},
+			{"Gateway", network.Gateway},
+		}); err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Deleting network %s...", network.Name))
+
+		if err := c.DeleteNetwork(network.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network %s deleted successfully", network.Name))
+		return nil
+	},
+}
+
+// networkOfferingsCmd lists available network offerings
+var networkOfferingsCmd = &cobra.Command{
+	Use:   "offerings",
+	Short: "List available network offerings",
+	Long:  `List the network offerings that can be used to create networks.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		offerings, err := c.ListNetworkOfferings(region)
+		if err != nil {
+			return fmt.Errorf("failed to list network offerings: %w", err)
+		}
+
+		if len(offerings) == 0 {
+			output.PrintInfo("No network offerings found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(offerings))
+		for i, off := range offerings {
+			dataSlice[i] = off
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "TYPE", "STATE", "DESCRIPTION"},
+			func(item interface{}) []string {
+				off := item.(models.NetworkOffering)
+				return []string{off.UUID, off.Name, off.GuestIPType, off.State, off.DisplayText}
 			},
 		)
 	},
 }
 
+// validateNetworkCIDR parses a CIDR for a new network and checks that it
+// does not overlap existing networks. It returns the normalized network and
+// the gateway, defaulting to the first usable address.
+func validateNetworkCIDR(cidr, gateway string, existing []models.Network) (*net.IPNet, string, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+	}
+	if ip.To4() == nil {
+		return nil, "", fmt.Errorf("invalid CIDR '%s': only IPv4 networks are supported", cidr)
+	}
+	if !ip.Equal(ipNet.IP) {
+		return nil, "", fmt.Errorf("invalid CIDR '%s': host bits are set, did you mean %s?", cidr, ipNet)
+	}
+	if ones, _ := ipNet.Mask.Size(); ones > 29 {
+		return nil, "", fmt.Errorf("invalid CIDR '%s': prefix must be /29 or larger", cidr)
+	}
+
+	if gateway == "" {
+		gw := make(net.IP, len(ipNet.IP.To4()))
+		copy(gw, ipNet.IP.To4())
+		gw[3]++
+		gateway = gw.String()
+	} else {
+		gw := net.ParseIP(gateway)
+		if gw == nil || gw.To4() == nil {
+			return nil, "", fmt.Errorf("invalid gateway '%s'", gateway)
+		}
+		if !ipNet.Contains(gw) || gw.Equal(ipNet.IP) || gw.Equal(broadcastAddress(ipNet)) {
+			return nil, "", fmt.Errorf("gateway %s is not a usable address in %s", gateway, ipNet)
+		}
+	}
+
+	for _, n := range existing {
+		if n.Cidr == "" {
+			continue
+		}
+		_, other, err := net.ParseCIDR(n.Cidr)
+		if err != nil {
+			continue
+		}
+		if other.Contains(ipNet.IP) || ipNet.Contains(other.IP) {
+			return nil, "", fmt.Errorf("CIDR %s overlaps network '%s' (%s)", ipNet, n.Name, n.Cidr)
+		}
+	}
+
+	return ipNet, gateway, nil
+}
+
+// broadcastAddress returns the last address of an IPv4 network
+func broadcastAddress(ipNet *net.IPNet) net.IP {
+	ip := ipNet.IP.To4()
+	broadcast := make(net.IP, len(ip))
+	for i := range ip {
+		broadcast[i] = ip[i] | ^ipNet.Mask[i]
+	}
+	return broadcast
+}
+
 func init() {
 	rootCmd.AddCommand(networkCmd)
 	networkCmd.AddCommand(networkListCmd)
+	networkCmd.AddCommand(networkGetCmd)
+	networkCmd.AddCommand(networkCreateCmd)
+	networkCmd.AddCommand(networkUpdateCmd)
+	networkCmd.AddCommand(networkDeleteCmd)
+	networkCmd.AddCommand(networkOfferingsCmd)
+
+	networkListCmd.Flags().String("selector", "", "Filter by tags, e.g. env=prod,team=web")
+
+	networkCreateCmd.Flags().String("name", "", "Network name (required)")
+	networkCreateCmd.Flags().String("description", "", "Network description (defaults to the name)")
+	networkCreateCmd.Flags().String("cidr", "", "Network CIDR, e.g. 10.1.0.0/24 (required)")
+	networkCreateCmd.Flags().String("gateway", "", "Gateway address (defaults to the first address in the CIDR)")
+	networkCreateCmd.Flags().String("offering", "", "Network offering name or UUID (required)")
+	networkCreateCmd.Flags().StringArray("tag", nil, "Tag as key=value (repeatable)")
+
+	networkUpdateCmd.Flags().String("name", "", "New network name")
+	networkUpdateCmd.Flags().String("description", "", "New network description")
+	networkUpdateCmd.Flags().String("offering", "", "New network offering, name or UUID")
 }
@@ -0,0 +1,488 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// networkACLCmd groups network ACL commands
+var networkACLCmd = &cobra.Command{
+	Use:   "acl",
+	Short: "Manage VPC network ACLs",
+	Long: `Manage the network ACLs that control traffic in and out of VPC tiers.
+
+An ACL is an ordered list of allow/deny rules evaluated by rule number. Each
+tier has exactly one ACL; new ACLs are applied to a tier with 'network acl assign'.`,
+}
+
+// networkACLListCmd lists the ACLs of a VPC
+var networkACLListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List network ACLs of a VPC",
+	Long:  `List the network ACLs available to a VPC, including the platform default ACLs.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		acls, err := c.ListNetworkACLs(vpc.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list network ACLs: %w", err)
+		}
+
+		if len(acls) == 0 {
+			output.PrintInfo("No network ACLs found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(acls))
+		for i, acl := range acls {
+			dataSlice[i] = acl
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "DESCRIPTION", "SCOPE"},
+			func(item interface{}) []string {
+				acl := item.(models.NetworkACL)
+				scope := "vpc"
+				if acl.VpcUUID == "" {
+					scope = "default"
+				}
+				return []string{acl.UUID, acl.Name, valueOr(acl.Description, "-"), scope}
+			},
+		)
+	},
+}
+
+// networkACLCreateCmd creates an ACL
+var networkACLCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a network ACL",
+	Long:  `Create an empty network ACL in a VPC. Add rules with 'network acl rule add'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+
+		if name == "" {
+			return fmt.Errorf("required flag: --name")
+		}
+
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		if description == "" {
+			description = name
+		}
+
+		acl, err := c.CreateNetworkACL(models.CreateNetworkACLRequest{
+			Name:        name,
+			Description: description,
+			VpcUUID:     vpc.UUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create network ACL: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network ACL created: %s (UUID: %s)", acl.Name, acl.UUID))
+		return nil
+	},
+}
+
+// networkACLDeleteCmd deletes an ACL
+var networkACLDeleteCmd = &cobra.Command{
+	Use:   "delete <acl>",
+	Short: "Delete a network ACL",
+	Long: `Delete a network ACL and all of its rules. The ACL must not be applied to any tier.
+
+You will be asked to type the ACL name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		acl, err := c.FindNetworkACL(args[0], vpc.UUID)
+		if err != nil {
+			return err
+		}
+		if acl.VpcUUID == "" {
+			return fmt.Errorf("%s is a platform default ACL and cannot be deleted", acl.Name)
+		}
+
+		tiers, err := vpcTiers(c, vpc.UUID, vpc.ZoneName)
+		if err != nil {
+			return err
+		}
+		for _, tier := range tiers {
+			if tier.ACLUUID == acl.UUID {
+				return fmt.Errorf("network ACL %s is applied to tier %s, assign another ACL first", acl.Name, tier.Name)
+			}
+		}
+
+		if err := confirmDestructive("network ACL", acl.Name, []resourceDetail{
+			{"Name", acl.Name},
+			{"UUID", acl.UUID},
+			{"VPC", vpc.Name},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteNetworkACL(acl.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network ACL %s deleted successfully", acl.Name))
+		return nil
+	},
+}
+
+// networkACLAssignCmd applies an ACL to a tier
+var networkACLAssignCmd = &cobra.Command{
+	Use:   "assign <acl>",
+	Short: "Apply a network ACL to a VPC tier",
+	Long:  `Replace the network ACL of a VPC tier.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		networkRef, _ := cmd.Flags().GetString("network")
+		if networkRef == "" {
+			return fmt.Errorf("required flag: --network")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		tier, err := c.FindNetwork(networkRef, region)
+		if err != nil {
+			return err
+		}
+		if tier.VpcUUID == "" {
+			return fmt.Errorf("network %s is not a VPC tier, network ACLs only apply to VPC tiers", tier.Name)
+		}
+
+		acl, err := c.FindNetworkACL(args[0], tier.VpcUUID)
+		if err != nil {
+			return err
+		}
+
+		if err := c.ReplaceNetworkACL(tier.UUID, acl.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Network ACL %s applied to tier %s", acl.Name, tier.Name))
+		return nil
+	},
+}
+
+// networkACLRuleCmd groups ACL rule commands
+var networkACLRuleCmd = &cobra.Command{
+	Use:   "rule",
+	Short: "Manage network ACL rules",
+	Long:  `List, add and delete the rules of a network ACL.`,
+}
+
+// networkACLRuleListCmd lists the rules of an ACL
+var networkACLRuleListCmd = &cobra.Command{
+	Use:   "list <acl>",
+	Short: "List the rules of a network ACL",
+	Long:  `List the rules of a network ACL in evaluation order.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		acl, err := c.FindNetworkACL(args[0], vpc.UUID)
+		if err != nil {
+			return err
+		}
+
+		rules, err := c.ListNetworkACLRules(acl.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list network ACL rules: %w", err)
+		}
+
+		if len(rules) == 0 {
+			output.PrintInfo("No rules found")
+			return nil
+		}
+
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Number < rules[j].Number })
+
+		dataSlice := make([]interface{}, len(rules))
+		for i, rule := range rules {
+			dataSlice[i] = rule
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"#", "UUID", "TRAFFIC", "ACTION", "PROTOCOL", "PORT RANGE", "CIDR", "STATE"},
+			func(item interface{}) []string {
+				rule := item.(models.NetworkACLRule)
+				return []string{
+					fmt.Sprintf("%d", rule.Number), rule.UUID, rule.TrafficType, rule.Action, rule.Protocol,
+					formatPortRange(models.FirewallRule{
+						Protocol: rule.Protocol, StartPort: rule.StartPort, EndPort: rule.EndPort,
+						IcmpType: rule.IcmpType, IcmpCode: rule.IcmpCode,
+					}),
+					rule.CidrList, rule.State,
+				}
+			},
+		)
+	},
+}
+
+// networkACLRuleAddCmd adds rules to an ACL
+var networkACLRuleAddCmd = &cobra.Command{
+	Use:   "add <acl>",
+	Short: "Add rules to a network ACL",
+	Long: `Add allow or deny rules to a network ACL.
+
+One rule is created per --port, numbered from --number upwards. Without
+--number the rules are appended after the highest existing rule.
+
+Examples:
+  sannti network acl rule add web-acl --vpc prod --action allow --protocol tcp --port 443 --cidr 0.0.0.0/0
+  sannti network acl rule add web-acl --vpc prod --number 200 --action deny --traffic egress --protocol all --cidr 0.0.0.0/0`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		number, _ := cmd.Flags().GetInt("number")
+		action, _ := cmd.Flags().GetString("action")
+		traffic, _ := cmd.Flags().GetString("traffic")
+		protocol, _ := cmd.Flags().GetString("protocol")
+		ports, _ := cmd.Flags().GetStringArray("port")
+		cidrs, _ := cmd.Flags().GetStringSlice("cidr")
+		icmpType, _ := cmd.Flags().GetInt("icmp-type")
+		icmpCode, _ := cmd.Flags().GetInt("icmp-code")
+
+		if action == "" || protocol == "" {
+			return fmt.Errorf("required flags: --action, --protocol")
+		}
+
+		action = strings.ToLower(action)
+		if action != "allow" && action != "deny" {
+			return fmt.Errorf("invalid action '%s': must be allow or deny", action)
+		}
+		traffic = strings.ToLower(traffic)
+		if traffic != "ingress" && traffic != "egress" {
+			return fmt.Errorf("invalid traffic type '%s': must be ingress or egress", traffic)
+		}
+
+		if (cmd.Flags().Changed("icmp-type") || cmd.Flags().Changed("icmp-code")) && strings.ToLower(protocol) != "icmp" {
+			return fmt.Errorf("--icmp-type and --icmp-code are only valid with --protocol icmp")
+		}
+
+		specs, err := newFirewallRuleSpecs(protocol, ports, cidrs, icmpType, icmpCode)
+		if err != nil {
+			return err
+		}
+
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		acl, err := c.FindNetworkACL(args[0], vpc.UUID)
+		if err != nil {
+			return err
+		}
+		if acl.VpcUUID == "" {
+			return fmt.Errorf("%s is a platform default ACL and cannot be changed, create your own with 'network acl create'", acl.Name)
+		}
+
+		existing, err := c.ListNetworkACLRules(acl.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list network ACL rules: %w", err)
+		}
+
+		used := make(map[int]bool, len(existing))
+		highest := 0
+		for _, rule := range existing {
+			used[rule.Number] = true
+			if rule.Number > highest {
+				highest = rule.Number
+			}
+		}
+
+		if number == 0 {
+			number = highest + 1
+		}
+		if number < 1 {
+			return fmt.Errorf("invalid rule number %d: must be at least 1", number)
+		}
+		for i := range specs {
+			if used[number+i] {
+				return fmt.Errorf("rule number %d is already used in %s, pick another --number", number+i, acl.Name)
+			}
+		}
+
+		for i, spec := range specs {
+			fw := spec.request("")
+			req := models.CreateNetworkACLRuleRequest{
+				ACLUUID:     acl.UUID,
+				Number:      number + i,
+				Protocol:    fw.Protocol,
+				StartPort:   fw.StartPort,
+				EndPort:     fw.EndPort,
+				CidrList:    fw.CidrList,
+				Action:      action,
+				TrafficType: traffic,
+				IcmpType:    fw.IcmpType,
+				IcmpCode:    fw.IcmpCode,
+			}
+
+			output.PrintInfo(fmt.Sprintf("Adding rule #%d: %s %s %s...", req.Number, action, traffic, spec))
+
+			rule, err := c.CreateNetworkACLRule(req)
+			if err != nil {
+				return fmt.Errorf("failed to add rule #%d: %w", req.Number, err)
+			}
+
+			output.PrintSuccess(fmt.Sprintf("Rule #%d added (UUID: %s)", req.Number, rule.UUID))
+		}
+
+		return nil
+	},
+}
+
+// networkACLRuleDeleteCmd deletes rules from an ACL
+var networkACLRuleDeleteCmd = &cobra.Command{
+	Use:   "delete <acl> <rule-uuid>...",
+	Short: "Delete network ACL rules",
+	Long: `Delete one or more rules from a network ACL.
+
+You will be asked to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, vpc, err := aclContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		acl, err := c.FindNetworkACL(args[0], vpc.UUID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := c.ListNetworkACLRules(acl.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list network ACL rules: %w", err)
+		}
+		byUUID := make(map[string]models.NetworkACLRule, len(existing))
+		for _, rule := range existing {
+			byUUID[rule.UUID] = rule
+		}
+
+		var rules []models.NetworkACLRule
+		for _, uuid := range args[1:] {
+			rule, ok := byUUID[uuid]
+			if !ok {
+				return fmt.Errorf("rule %s not found in network ACL %s", uuid, acl.Name)
+			}
+			rules = append(rules, rule)
+		}
+
+		fmt.Printf("The following rules will be deleted from %s:\n", acl.Name)
+		fmt.Println()
+		for _, rule := range rules {
+			fmt.Printf("  #%-4d %s  %s %s %s from %s\n", rule.Number, rule.UUID, rule.Action, rule.TrafficType, rule.Protocol, rule.CidrList)
+		}
+		fmt.Println()
+
+		if err := confirmAction(fmt.Sprintf("Delete %d rule(s)?", len(rules))); err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if err := c.DeleteNetworkACLRule(rule.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Rule #%d deleted successfully", rule.Number))
+		}
+
+		return nil
+	},
+}
+
+// aclContext loads the client and resolves the --vpc flag shared by ACL commands
+func aclContext(cmd *cobra.Command) (*client.Client, *models.VPC, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vpcRef, _ := cmd.Flags().GetString("vpc")
+	if vpcRef == "" {
+		return nil, nil, fmt.Errorf("required flag: --vpc")
+	}
+
+	c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+	region := regionFlag
+	if region == "" {
+		region = cfg.DefaultRegion
+	}
+
+	vpc, err := c.FindVPC(vpcRef, region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, vpc, nil
+}
+
+func init() {
+	networkCmd.AddCommand(networkACLCmd)
+	networkACLCmd.AddCommand(networkACLListCmd)
+	networkACLCmd.AddCommand(networkACLCreateCmd)
+	networkACLCmd.AddCommand(networkACLDeleteCmd)
+	networkACLCmd.AddCommand(networkACLAssignCmd)
+	networkACLCmd.AddCommand(networkACLRuleCmd)
+	networkACLRuleCmd.AddCommand(networkACLRuleListCmd)
+	networkACLRuleCmd.AddCommand(networkACLRuleAddCmd)
+	networkACLRuleCmd.AddCommand(networkACLRuleDeleteCmd)
+
+	for _, cmd := range []*cobra.Command{
+		networkACLListCmd, networkACLCreateCmd, networkACLDeleteCmd,
+		networkACLRuleListCmd, networkACLRuleAddCmd, networkACLRuleDeleteCmd,
+	} {
+		cmd.Flags().String("vpc", "", "VPC the ACL belongs to, name or UUID (required)")
+	}
+
+	networkACLCreateCmd.Flags().String("name", "", "ACL name (required)")
+	networkACLCreateCmd.Flags().String("description", "", "ACL description (defaults to the name)")
+
+	networkACLAssignCmd.Flags().String("network", "", "VPC tier to apply the ACL to, name or UUID (required)")
+
+	networkACLRuleAddCmd.Flags().Int("number", 0, "Rule number; lower numbers are evaluated first (default: append)")
+	networkACLRuleAddCmd.Flags().String("action", "", "allow or deny (required)")
+	networkACLRuleAddCmd.Flags().String("traffic", "ingress", "Traffic direction: ingress or egress")
+	networkACLRuleAddCmd.Flags().String("protocol", "", "Protocol: tcp, udp, icmp or all (required)")
+	networkACLRuleAddCmd.Flags().StringArray("port", nil, "Port or port range, e.g. 22 or 8000-8100 (repeatable)")
+	networkACLRuleAddCmd.Flags().StringSlice("cidr", nil, "Source (ingress) or destination (egress) CIDR (repeatable or comma-separated)")
+	networkACLRuleAddCmd.Flags().Int("icmp-type", -1, "ICMP type, -1 for any")
+	networkACLRuleAddCmd.Flags().Int("icmp-code", -1, "ICMP code, -1 for any")
+}
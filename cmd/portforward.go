@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// portForwardCmd represents the portforward command
+var portForwardCmd = &cobra.Command{
+	Use:     "portforward",
+	Aliases: []string{"port-forward", "pf"},
+	Short:   "Manage port forwarding rules",
+	Long:    `List and manage rules that forward ports on a public IP address to instances.`,
+}
+
+// portForwardListCmd lists port forwarding rules
+var portForwardListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List port forwarding rules",
+	Long:  `List all port forwarding rules, optionally filtered by the public IP they apply to.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		ipRef, _ := cmd.Flags().GetString("ip")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		rules, err := c.ListPortForwardingRules(region)
+		if err != nil {
+			return fmt.Errorf("failed to list port forwarding rules: %w", err)
+		}
+
+		if ipRef != "" {
+			ip, err := c.FindIPAddress(ipRef, region)
+			if err != nil {
+				return err
+			}
+			rules = portForwardsForIP(rules, *ip)
+		}
+
+		if len(rules) == 0 {
+			output.PrintInfo("No port forwarding rules found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(rules))
+		for i, rule := range rules {
+			dataSlice[i] = rule
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "IP ADDRESS", "PROTOCOL", "PUBLIC PORT", "INSTANCE", "PRIVATE PORT", "STATE"},
+			func(item interface{}) []string {
+				rule := item.(models.PortForwardingRule)
+				return []string{
+					rule.UUID,
+					valueOr(rule.IPAddress, "-"),
+					rule.Protocol,
+					formatPorts(rule.PublicStartPort, rule.PublicEndPort),
+					valueOr(rule.VirtualMachineName, rule.VirtualMachineUUID),
+					formatPorts(rule.PrivateStartPort, rule.PrivateEndPort),
+					rule.State,
+				}
+			},
+		)
+	},
+}
+
+// portForwardCreateCmd creates a port forwarding rule
+var portForwardCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a port forwarding rule",
+	Long: `Forward a public port or port range on an IP address to an instance.
+
+The private port defaults to the public port. When ranges are used, both
+ranges must be the same size. Rules that overlap an existing rule on the same
+IP and protocol are rejected.
+
+Examples:
+  sannti portforward create --ip 203.0.113.10 --public-port 2222 --instance web-1 --private-port 22
+  sannti portforward create --ip 203.0.113.10 --protocol udp --public-port 5000-5010 --instance media-1`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		ipRef, _ := cmd.Flags().GetString("ip")
+		protocol, _ := cmd.Flags().GetString("protocol")
+		publicPort, _ := cmd.Flags().GetString("public-port")
+		instanceRef, _ := cmd.Flags().GetString("instance")
+		privatePort, _ := cmd.Flags().GetString("private-port")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if ipRef == "" || publicPort == "" || instanceRef == "" {
+			return fmt.Errorf("required flags: --ip, --public-port, --instance")
+		}
+
+		protocol = strings.ToLower(protocol)
+		if protocol != "tcp" && protocol != "udp" {
+			return fmt.Errorf("invalid protocol '%s': must be tcp or udp", protocol)
+		}
+
+		publicStart, publicEnd, err := parsePortRange(publicPort)
+		if err != nil {
+			return err
+		}
+
+		privateStart, privateEnd := publicStart, publicEnd
+		if privatePort != "" {
+			if privateStart, privateEnd, err = parsePortRange(privatePort); err != nil {
+				return err
+			}
+		}
+		if publicEnd-publicStart != privateEnd-privateStart {
+			return fmt.Errorf("public port range %s and private port range %s must be the same size", publicPort, privatePort)
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		ip, err := c.FindIPAddress(ipRef, region)
+		if err != nil {
+			return err
+		}
+		if hasStaticNat(*ip) {
+			return fmt.Errorf("%s has static NAT to instance %s, port forwarding is not needed", ip.IpAddress, valueOr(ip.VirtualMachineName, ip.VirtualMachineUUID))
+		}
+
+		inst, err := c.FindInstance(instanceRef, region)
+		if err != nil {
+			return err
+		}
+		if ip.NetworkName != "" && inst.NetworkName != "" && ip.NetworkName != inst.NetworkName {
+			return fmt.Errorf("%s belongs to network %s but instance %s is on %s", ip.IpAddress, ip.NetworkName, inst.Name, inst.NetworkName)
+		}
+
+		existing, err := c.ListPortForwardingRules(region)
+		if err != nil {
+			return fmt.Errorf("failed to list port forwarding rules: %w", err)
+		}
+		if conflict := findPortForwardConflict(portForwardsForIP(existing, *ip), protocol, publicStart, publicEnd); conflict != nil {
+			return fmt.Errorf("%s/%s on %s conflicts with rule %s (%s/%s to %s)",
+				protocol, publicPort, ip.IpAddress, conflict.UUID, conflict.Protocol,
+				formatPorts(conflict.PublicStartPort, conflict.PublicEndPort),
+				valueOr(conflict.VirtualMachineName, conflict.VirtualMachineUUID))
+		}
+
+		output.PrintInfo(fmt.Sprintf("Forwarding %s/%s on %s to %s...", protocol, publicPort, ip.IpAddress, inst.Name))
+
+		rule, err := c.CreatePortForwardingRule(models.CreatePortForwardingRuleRequest{
+			IPAddressUUID:      ip.UUID,
+			Protocol:           protocol,
+			PublicStartPort:    publicStart,
+			PublicEndPort:      publicEnd,
+			PrivateStartPort:   privateStart,
+			PrivateEndPort:     privateEnd,
+			VirtualMachineUUID: inst.UUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create port forwarding rule: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Port forwarding rule created (UUID: %s)", rule.UUID))
+		output.PrintInfo("Traffic must also be allowed by a firewall rule, see 'sannti firewall create'")
+		return nil
+	},
+}
+
+// portForwardDeleteCmd deletes port forwarding rules
+var portForwardDeleteCmd = &cobra.Command{
+	Use:   "delete <uuid>...",
+	Short: "Delete port forwarding rules",
+	Long: `Delete one or more port forwarding rules by UUID.
+
+You will be asked to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		var rules []*models.PortForwardingRule
+		for _, uuid := range args {
+			rule, err := c.FindPortForwardingRule(uuid, region)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, rule)
+		}
+
+		fmt.Println("The following port forwarding rules will be deleted:")
+		fmt.Println()
+		for _, rule := range rules {
+			fmt.Printf("  %s  %s\n", rule.UUID, formatPortForward(*rule))
+		}
+		fmt.Println()
+
+		if err := confirmAction(fmt.Sprintf("Delete %d port forwarding rule(s)?", len(rules))); err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if err := c.DeletePortForwardingRule(rule.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Port forwarding rule %s deleted successfully", rule.UUID))
+		}
+
+		return nil
+	},
+}
+
+// portForwardsForIP returns the rules that apply to a public IP address
+func portForwardsForIP(rules []models.PortForwardingRule, ip models.IPAddress) []models.PortForwardingRule {
+	var matched []models.PortForwardingRule
+	for _, rule := range rules {
+		if rule.IPAddressUUID == ip.UUID || rule.IPAddress == ip.IpAddress {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// findPortForwardConflict returns the first rule whose public ports overlap
+// the given range for the same protocol
+func findPortForwardConflict(rules []models.PortForwardingRule, protocol string, start, end int) *models.PortForwardingRule {
+	for _, rule := range rules {
+		if !strings.EqualFold(rule.Protocol, protocol) {
+			continue
+		}
+
+		ruleStart, err := strconv.Atoi(rule.PublicStartPort)
+		if err != nil {
+			continue
+		}
+		ruleEnd, err := strconv.Atoi(rule.PublicEndPort)
+		if err != nil {
+			ruleEnd = ruleStart
+		}
+
+		if start <= ruleEnd && ruleStart <= end {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// formatPorts renders a port or port range from API fields
+func formatPorts(start, end string) string {
+	if start == "" {
+		return "-"
+	}
+	if end == "" || end == start {
+		return start
+	}
+	return start + "-" + end
+}
+
+// formatPortForward renders a rule as protocol/public->instance:private
+func formatPortForward(rule models.PortForwardingRule) string {
+	return fmt.Sprintf("%s/%s->%s:%s",
+		strings.ToLower(rule.Protocol),
+		formatPorts(rule.PublicStartPort, rule.PublicEndPort),
+		valueOr(rule.VirtualMachineName, rule.VirtualMachineUUID),
+		formatPorts(rule.PrivateStartPort, rule.PrivateEndPort))
+}
+
+func init() {
+	rootCmd.AddCommand(portForwardCmd)
+	portForwardCmd.AddCommand(portForwardListCmd)
+	portForwardCmd.AddCommand(portForwardCreateCmd)
+	portForwardCmd.AddCommand(portForwardDeleteCmd)
+
+	portForwardListCmd.Flags().String("ip", "", "Only show rules for this public IP address or IP UUID")
+
+	portForwardCreateCmd.Flags().String("ip", "", "Public IP address or IP UUID to forward from (required)")
+	portForwardCreateCmd.Flags().String("protocol", "tcp", "Protocol: tcp or udp")
+	portForwardCreateCmd.Flags().String("public-port", "", "Public port or range, e.g. 2222 or 5000-5010 (required)")
+	portForwardCreateCmd.Flags().String("instance", "", "Instance to forward to, name or UUID (required)")
+	portForwardCreateCmd.Flags().String("private-port", "", "Private port or range on the instance (default: same as public)")
+}
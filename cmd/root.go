@@ -12,6 +12,7 @@ import (
 var (
 	outputFormat string
 	regionFlag   string
+	assumeYes    bool
 )
 
 // rootCmd represents the base command
@@ -40,6 +41,7 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json, yaml)")
 	rootCmd.PersistentFlags().StringVarP(&regionFlag, "region", "r", "", "Region (overrides default)")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Skip confirmation prompts for destructive commands")
 
 	// Initialize config
 	cobra.OnInitialize(initConfig)
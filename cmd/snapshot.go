@@ -0,0 +1,586 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:     "snapshot",
+	Aliases: []string{"snapshots", "snap"},
+	Short:   "Manage volume snapshots",
+	Long: `Take point-in-time snapshots of volumes, roll volumes back to them, restore
+them into new volumes and schedule recurring snapshots.`,
+}
+
+// snapshotListCmd lists volume snapshots
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List volume snapshots",
+	Long:  `List volume snapshots in a region, optionally only those of one volume.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		volumeRef, _ := cmd.Flags().GetString("volume")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snapshots, err := c.ListSnapshots(region)
+		if err != nil {
+			return fmt.Errorf("failed to list snapshots: %w", err)
+		}
+
+		if volumeRef != "" {
+			vol, err := c.FindVolume(volumeRef, region)
+			if err != nil {
+				return err
+			}
+			var filtered []models.Snapshot
+			for _, snap := range snapshots {
+				if snap.VolumeUUID == vol.UUID {
+					filtered = append(filtered, snap)
+				}
+			}
+			snapshots = filtered
+		}
+
+		if len(snapshots) == 0 {
+			output.PrintInfo("No snapshots found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(snapshots))
+		for i, snap := range snapshots {
+			dataSlice[i] = snap
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "VOLUME", "TYPE", "SIZE", "STATE", "CREATED"},
+			func(item interface{}) []string {
+				snap := item.(models.Snapshot)
+				return []string{
+					snap.UUID, snap.Name, valueOr(snap.VolumeName, snap.VolumeUUID), valueOr(snap.Type, "MANUAL"),
+					formatBytes(snap.Size), snap.State, valueOr(snap.Created, "-"),
+				}
+			},
+		)
+	},
+}
+
+// snapshotCreateCmd snapshots a volume
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Snapshot a volume",
+	Long: `Take a snapshot of a volume. Without --name the snapshot is named after the
+volume and the current time.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		volumeRef, _ := cmd.Flags().GetString("volume")
+		name, _ := cmd.Flags().GetString("name")
+		if volumeRef == "" {
+			return fmt.Errorf("required flag: --volume")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(volumeRef, region)
+		if err != nil {
+			return err
+		}
+
+		if name == "" {
+			name = fmt.Sprintf("%s-%s", vol.Name, time.Now().UTC().Format("20060102-150405"))
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating snapshot '%s' of volume %s...", name, vol.Name))
+
+		snap, err := c.CreateSnapshot(models.CreateSnapshotRequest{
+			Name:       name,
+			VolumeUUID: vol.UUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Snapshot created: %s (UUID: %s)", valueOr(snap.Name, name), snap.UUID))
+		return nil
+	},
+}
+
+// snapshotDeleteCmd deletes a volume snapshot
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a volume snapshot",
+	Long: `Delete a volume snapshot.
+
+You will be asked to type the snapshot name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snap, err := c.FindSnapshot(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructive("snapshot", snap.Name, []resourceDetail{
+			{"Name", snap.Name},
+			{"UUID", snap.UUID},
+			{"Volume", valueOr(snap.VolumeName, snap.VolumeUUID)},
+			{"Created", valueOr(snap.Created, "-")},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteSnapshot(snap.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Snapshot %s deleted successfully", snap.Name))
+		return nil
+	},
+}
+
+// snapshotRevertCmd rolls a volume back to a snapshot
+var snapshotRevertCmd = &cobra.Command{
+	Use:   "revert <name-or-uuid>",
+	Short: "Revert a volume to a snapshot",
+	Long: `Roll a volume back to the state captured in a snapshot. Everything written to
+the volume since the snapshot was taken is lost.
+
+The volume must be detached or its instance stopped. You will be asked to
+confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snap, err := c.FindSnapshot(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		vol, err := c.FindVolume(snap.VolumeUUID, region)
+		if err != nil {
+			return err
+		}
+
+		if vol.VirtualMachineUUID != "" {
+			inst, err := c.GetInstance(vol.VirtualMachineUUID, region)
+			if err != nil {
+				return fmt.Errorf("failed to get instance: %w", err)
+			}
+			if !strings.EqualFold(inst.State, "Stopped") {
+				return fmt.Errorf("volume %s is attached to %s which is %s, run 'sannti compute stop %s' first", vol.Name, inst.Name, inst.State, inst.UUID)
+			}
+		}
+
+		if err := confirmAction(fmt.Sprintf("Revert volume %s to snapshot %s? Changes made since %s will be lost.", vol.Name, snap.Name, valueOr(snap.Created, "the snapshot"))); err != nil {
+			return err
+		}
+
+		if err := c.RevertSnapshot(snap.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume %s reverted to snapshot %s", vol.Name, snap.Name))
+		return nil
+	},
+}
+
+// snapshotCreateVolumeCmd restores a snapshot into a new volume
+var snapshotCreateVolumeCmd = &cobra.Command{
+	Use:   "create-volume <snapshot>",
+	Short: "Restore a snapshot into a new volume",
+	Long: `Create a new volume from a snapshot, leaving the original volume untouched.
+With --instance the new volume is attached as soon as it is created.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		instanceRef, _ := cmd.Flags().GetString("instance")
+		if name == "" {
+			return fmt.Errorf("required flag: --name")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		snap, err := c.FindSnapshot(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		var inst *models.Instance
+		if instanceRef != "" {
+			if inst, err = c.FindInstance(instanceRef, region); err != nil {
+				return err
+			}
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating volume '%s' from snapshot %s...", name, snap.Name))
+
+		vol, err := c.CreateVolume(models.CreateVolumeRequest{
+			Name:         name,
+			SnapshotUUID: snap.UUID,
+			Region:       region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create volume: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume created: %s (UUID: %s)", vol.Name, vol.UUID))
+
+		if inst != nil {
+			if err := c.AttachVolume(vol.UUID, inst.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Volume attached to %s", inst.Name))
+		}
+
+		return nil
+	},
+}
+
+// snapshotPolicyCmd groups the recurring snapshot policy commands
+var snapshotPolicyCmd = &cobra.Command{
+	Use:     "policy",
+	Aliases: []string{"policies", "schedule"},
+	Short:   "Manage recurring snapshot policies",
+	Long: `Schedule hourly or daily snapshots of a volume. Each volume can have one
+hourly and one daily policy; older snapshots beyond --keep are removed
+automatically.`,
+}
+
+// snapshotPolicyListCmd lists the snapshot policies of a volume
+var snapshotPolicyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the snapshot policies of a volume",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		volumeRef, _ := cmd.Flags().GetString("volume")
+		if volumeRef == "" {
+			return fmt.Errorf("required flag: --volume")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(volumeRef, region)
+		if err != nil {
+			return err
+		}
+
+		policies, err := c.ListSnapshotPolicies(vol.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list snapshot policies: %w", err)
+		}
+
+		if len(policies) == 0 {
+			output.PrintInfo(fmt.Sprintf("No snapshot policies on volume %s", vol.Name))
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(policies))
+		for i, p := range policies {
+			dataSlice[i] = p
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "INTERVAL", "SCHEDULE", "KEEP", "TIMEZONE"},
+			func(item interface{}) []string {
+				p := item.(models.SnapshotPolicy)
+				return []string{p.UUID, p.IntervalType, formatSnapshotSchedule(p.IntervalType, p.Schedule), strconv.Itoa(p.MaxSnaps), valueOr(p.Timezone, "UTC")}
+			},
+		)
+	},
+}
+
+// snapshotPolicySetCmd creates or replaces a snapshot policy
+var snapshotPolicySetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set a recurring snapshot policy on a volume",
+	Long: `Set an hourly or daily snapshot policy on a volume, replacing any existing
+policy with the same interval.
+
+Hourly snapshots are taken at --minute past every hour; daily snapshots at
+--at (HH:MM) in --timezone.`,
+	Example: `  sannti snapshot policy set --volume data-1 --hourly --keep 24
+  sannti snapshot policy set --volume data-1 --daily --at 03:30 --keep 7`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		volumeRef, _ := cmd.Flags().GetString("volume")
+		keep, _ := cmd.Flags().GetInt("keep")
+		minute, _ := cmd.Flags().GetInt("minute")
+		at, _ := cmd.Flags().GetString("at")
+		timezone, _ := cmd.Flags().GetString("timezone")
+
+		if volumeRef == "" || keep <= 0 {
+			return fmt.Errorf("required flags: --volume, --keep")
+		}
+
+		interval, err := snapshotPolicyInterval(cmd)
+		if err != nil {
+			return err
+		}
+
+		schedule, err := snapshotSchedule(interval, minute, at, cmd.Flags().Changed("minute"), cmd.Flags().Changed("at"))
+		if err != nil {
+			return err
+		}
+
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return fmt.Errorf("invalid --timezone %q: %w", timezone, err)
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(volumeRef, region)
+		if err != nil {
+			return err
+		}
+
+		policy, err := c.CreateSnapshotPolicy(models.CreateSnapshotPolicyRequest{
+			VolumeUUID:   vol.UUID,
+			IntervalType: interval,
+			Schedule:     schedule,
+			MaxSnaps:     keep,
+			Timezone:     timezone,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set snapshot policy: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("%s snapshots of volume %s scheduled %s, keeping the last %d (UUID: %s)",
+			snapshotIntervalLabel(interval), vol.Name, formatSnapshotSchedule(interval, schedule), keep, policy.UUID))
+		return nil
+	},
+}
+
+// snapshotPolicyDeleteCmd removes a snapshot policy
+var snapshotPolicyDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Remove a recurring snapshot policy from a volume",
+	Long: `Remove the hourly or daily snapshot policy of a volume. Snapshots already
+taken are kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		volumeRef, _ := cmd.Flags().GetString("volume")
+		if volumeRef == "" {
+			return fmt.Errorf("required flag: --volume")
+		}
+
+		interval, err := snapshotPolicyInterval(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(volumeRef, region)
+		if err != nil {
+			return err
+		}
+
+		policies, err := c.ListSnapshotPolicies(vol.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to list snapshot policies: %w", err)
+		}
+
+		for _, p := range policies {
+			if !strings.EqualFold(p.IntervalType, interval) {
+				continue
+			}
+			if err := c.DeleteSnapshotPolicy(p.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("%s snapshot policy removed from volume %s", snapshotIntervalLabel(interval), vol.Name))
+			return nil
+		}
+
+		return fmt.Errorf("volume %s has no %s snapshot policy", vol.Name, strings.ToLower(interval))
+	},
+}
+
+// snapshotPolicyInterval reads the --hourly/--daily pair, exactly one of which must be set
+func snapshotPolicyInterval(cmd *cobra.Command) (string, error) {
+	hourly, _ := cmd.Flags().GetBool("hourly")
+	daily, _ := cmd.Flags().GetBool("daily")
+
+	switch {
+	case hourly && daily:
+		return "", fmt.Errorf("--hourly and --daily are mutually exclusive")
+	case hourly:
+		return "HOURLY", nil
+	case daily:
+		return "DAILY", nil
+	default:
+		return "", fmt.Errorf("required flag: --hourly or --daily")
+	}
+}
+
+// snapshotIntervalLabel renders HOURLY/DAILY as "Hourly"/"Daily"
+func snapshotIntervalLabel(interval string) string {
+	if interval == "" {
+		return interval
+	}
+	return strings.ToUpper(interval[:1]) + strings.ToLower(interval[1:])
+}
+
+// snapshotSchedule builds the API schedule string: "MM" for hourly, "MM:HH" for daily
+func snapshotSchedule(interval string, minute int, at string, minuteSet, atSet bool) (string, error) {
+	if interval == "HOURLY" {
+		if atSet {
+			return "", fmt.Errorf("--at only applies to daily policies, use --minute")
+		}
+		if minute < 0 || minute > 59 {
+			return "", fmt.Errorf("invalid --minute %d: must be between 0 and 59", minute)
+		}
+		return strconv.Itoa(minute), nil
+	}
+
+	if minuteSet {
+		return "", fmt.Errorf("--minute only applies to hourly policies, use --at")
+	}
+	t, err := time.Parse("15:04", at)
+	if err != nil {
+		return "", fmt.Errorf("invalid --at %q: expected HH:MM", at)
+	}
+	return fmt.Sprintf("%d:%d", t.Minute(), t.Hour()), nil
+}
+
+// formatSnapshotSchedule renders an API schedule string for display
+func formatSnapshotSchedule(interval, schedule string) string {
+	parts := strings.Split(schedule, ":")
+	minute, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return schedule
+	}
+
+	if strings.EqualFold(interval, "HOURLY") {
+		return fmt.Sprintf("every hour at :%02d", minute)
+	}
+	if len(parts) == 2 {
+		if hour, err := strconv.Atoi(parts[1]); err == nil {
+			return fmt.Sprintf("every day at %02d:%02d", hour, minute)
+		}
+	}
+	return schedule
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotDeleteCmd)
+	snapshotCmd.AddCommand(snapshotRevertCmd)
+	snapshotCmd.AddCommand(snapshotCreateVolumeCmd)
+	snapshotCmd.AddCommand(snapshotPolicyCmd)
+	snapshotPolicyCmd.AddCommand(snapshotPolicyListCmd)
+	snapshotPolicyCmd.AddCommand(snapshotPolicySetCmd)
+	snapshotPolicyCmd.AddCommand(snapshotPolicyDeleteCmd)
+
+	snapshotListCmd.Flags().String("volume", "", "Only show snapshots of this volume (name or UUID)")
+
+	snapshotCreateCmd.Flags().String("volume", "", "Volume to snapshot, name or UUID (required)")
+	snapshotCreateCmd.Flags().String("name", "", "Snapshot name (default: <volume>-<timestamp>)")
+
+	snapshotCreateVolumeCmd.Flags().String("name", "", "Name of the new volume (required)")
+	snapshotCreateVolumeCmd.Flags().String("instance", "", "Attach the new volume to this instance (name or UUID)")
+
+	snapshotPolicyListCmd.Flags().String("volume", "", "Volume, name or UUID (required)")
+
+	snapshotPolicySetCmd.Flags().String("volume", "", "Volume, name or UUID (required)")
+	snapshotPolicySetCmd.Flags().Bool("hourly", false, "Take a snapshot every hour")
+	snapshotPolicySetCmd.Flags().Bool("daily", false, "Take a snapshot every day")
+	snapshotPolicySetCmd.Flags().Int("keep", 0, "Number of snapshots to retain (required)")
+	snapshotPolicySetCmd.Flags().Int("minute", 0, "Minute past the hour for hourly snapshots")
+	snapshotPolicySetCmd.Flags().String("at", "00:00", "Time of day (HH:MM) for daily snapshots")
+	snapshotPolicySetCmd.Flags().String("timezone", "UTC", "Timezone the schedule is evaluated in")
+
+	snapshotPolicyDeleteCmd.Flags().String("volume", "", "Volume, name or UUID (required)")
+	snapshotPolicyDeleteCmd.Flags().Bool("hourly", false, "Remove the hourly policy")
+	snapshotPolicyDeleteCmd.Flags().Bool("daily", false, "Remove the daily policy")
+}
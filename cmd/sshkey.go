@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+	"golang.org/x/crypto/ssh"
+)
+
+// sshKeyCmd represents the ssh-key command
+var sshKeyCmd = &cobra.Command{
+	Use:     "ssh-key",
+	Aliases: []string{"ssh-keys", "keypair"},
+	Short:   "Manage SSH key pairs",
+	Long:    `List, import, create and delete the SSH key pairs used to access compute instances.`,
+}
+
+// sshKeyListCmd lists SSH key pairs
+var sshKeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List SSH key pairs",
+	Long:  `List all SSH key pairs registered in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		keys, err := c.ListSSHKeys(region)
+		if err != nil {
+			return fmt.Errorf("failed to list SSH keys: %w", err)
+		}
+
+		if len(keys) == 0 {
+			output.PrintInfo("No SSH keys found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(keys))
+		for i, k := range keys {
+			dataSlice[i] = k
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "FINGERPRINT"},
+			func(item interface{}) []string {
+				k := item.(models.SSHKeyPair)
+				return []string{k.UUID, k.Name, k.Fingerprint}
+			},
+		)
+	},
+}
+
+// sshKeyImportCmd imports a local public key
+var sshKeyImportCmd = &cobra.Command{
+	Use:   "import <name>",
+	Short: "Import a local public key",
+	Long: `Register an existing local public key with Sannti Cloud.
+
+By default ~/.ssh/id_ed25519.pub is used; pass --public-key-file to choose another key.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		name := args[0]
+		keyFile, _ := cmd.Flags().GetString("public-key-file")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		keyFile, err = expandPath(keyFile)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read public key: %w", err)
+		}
+
+		pubKey, _, _, _, err := ssh.ParseAuthorizedKey(data)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid OpenSSH public key: %w", keyFile, err)
+		}
+
+		key, err := c.ImportSSHKey(models.ImportSSHKeyRequest{
+			Name:      name,
+			PublicKey: strings.TrimSpace(string(data)),
+			Region:    region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import SSH key: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("SSH key imported: %s (%s)", key.Name, ssh.FingerprintSHA256(pubKey)))
+		return nil
+	},
+}
+
+// sshKeyCreateCmd generates a new key pair locally and registers it
+var sshKeyCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Generate and register a new key pair",
+	Long: `Generate a new ed25519 key pair locally and register its public half.
+
+The private key never leaves this machine. It is written to ~/.ssh/sannti_<name>
+(or --private-key-file) with the public key alongside it as a .pub file.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		name := args[0]
+		privateFile, _ := cmd.Flags().GetString("private-key-file")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if privateFile == "" {
+			privateFile = "~/.ssh/sannti_" + name
+		}
+		privateFile, err = expandPath(privateFile)
+		if err != nil {
+			return err
+		}
+		publicFile := privateFile + ".pub"
+
+		for _, f := range []string{privateFile, publicFile} {
+			if _, err := os.Stat(f); err == nil {
+				return fmt.Errorf("%s already exists, refusing to overwrite", f)
+			}
+		}
+
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return fmt.Errorf("failed to generate key: %w", err)
+		}
+
+		block, err := ssh.MarshalPrivateKey(priv, name)
+		if err != nil {
+			return fmt.Errorf("failed to encode private key: %w", err)
+		}
+
+		sshPub, err := ssh.NewPublicKey(pub)
+		if err != nil {
+			return fmt.Errorf("failed to encode public key: %w", err)
+		}
+		authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))) + " " + name
+
+		if err := os.MkdirAll(filepath.Dir(privateFile), 0700); err != nil {
+			return fmt.Errorf("failed to create key directory: %w", err)
+		}
+		if err := os.WriteFile(privateFile, pem.EncodeToMemory(block), 0600); err != nil {
+			return fmt.Errorf("failed to write private key: %w", err)
+		}
+		if err := os.WriteFile(publicFile, []byte(authorizedKey+"\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write public key: %w", err)
+		}
+
+		key, err := c.ImportSSHKey(models.ImportSSHKeyRequest{
+			Name:      name,
+			PublicKey: authorizedKey,
+			Region:    region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register SSH key (local files kept at %s): %w", privateFile, err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("SSH key created: %s (%s)", key.Name, ssh.FingerprintSHA256(sshPub)))
+		output.PrintInfo(fmt.Sprintf("Private key saved to %s", privateFile))
+		return nil
+	},
+}
+
+// sshKeyDeleteCmd deletes a key pair
+var sshKeyDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete an SSH key pair",
+	Long: `Delete an SSH key pair from Sannti Cloud. Local key files are not touched.
+
+You will be asked to type the key name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		key, err := c.GetSSHKey(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructive("SSH key", key.Name, []resourceDetail{
+			{"Name", key.Name},
+			{"UUID", key.UUID},
+			{"Region", region},
+			{"Fingerprint", key.Fingerprint},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteSSHKey(key.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("SSH key %s deleted successfully", key.Name))
+		return nil
+	},
+}
+
+// expandPath expands a leading ~ to the user's home directory
+func expandPath(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+func init() {
+	rootCmd.AddCommand(sshKeyCmd)
+	sshKeyCmd.AddCommand(sshKeyListCmd)
+	sshKeyCmd.AddCommand(sshKeyImportCmd)
+	sshKeyCmd.AddCommand(sshKeyCreateCmd)
+	sshKeyCmd.AddCommand(sshKeyDeleteCmd)
+
+	sshKeyImportCmd.Flags().String("public-key-file", "~/.ssh/id_ed25519.pub", "Path to the public key to import")
+	sshKeyCreateCmd.Flags().String("private-key-file", "", "Where to write the private key (default ~/.ssh/sannti_<name>)")
+}
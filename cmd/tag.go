@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// tagCmd represents the tag command
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage resource tags",
+	Long: `Add, remove and list key/value tags on Sannti Cloud resources.
+
+Supported resource types: instance, network, k8s, ip`,
+}
+
+// tagAddCmd adds tags to a resource
+var tagAddCmd = &cobra.Command{
+	Use:   "add <resource-type> <uuid> <key=value>...",
+	Short: "Add tags to a resource",
+	Long:  `Add one or more key=value tags to a resource. Existing keys are overwritten.`,
+	Args:  cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		resourceType, uuid := args[0], args[1]
+
+		tags, err := parseTags(args[2:])
+		if err != nil {
+			return err
+		}
+
+		if err := c.CreateTags(resourceType, uuid, tags); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Tagged %s %s with %s", resourceType, uuid, formatTags(tags)))
+		return nil
+	},
+}
+
+// tagRemoveCmd removes tags from a resource
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove <resource-type> <uuid> <key>...",
+	Short: "Remove tags from a resource",
+	Long:  `Remove one or more tags from a resource by key.`,
+	Args:  cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		resourceType, uuid := args[0], args[1]
+
+		tags := make([]models.Tag, len(args[2:]))
+		for i, key := range args[2:] {
+			tags[i] = models.Tag{Key: key}
+		}
+
+		if err := c.DeleteTags(resourceType, uuid, tags); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Removed %d tag(s) from %s %s", len(tags), resourceType, uuid))
+		return nil
+	},
+}
+
+// tagListCmd lists tags
+var tagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tags",
+	Long:  `List tags, optionally filtered by resource type and resource UUID.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		resourceType, _ := cmd.Flags().GetString("type")
+		resourceUUID, _ := cmd.Flags().GetString("resource")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		tags, err := c.ListTags(resourceType, resourceUUID, region)
+		if err != nil {
+			return fmt.Errorf("failed to list tags: %w", err)
+		}
+
+		if len(tags) == 0 {
+			output.PrintInfo("No tags found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(tags))
+		for i, t := range tags {
+			dataSlice[i] = t
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"RESOURCE TYPE", "RESOURCE UUID", "KEY", "VALUE"},
+			func(item interface{}) []string {
+				t := item.(models.Tag)
+				return []string{client.TagResourceTypeName(t.ResourceType), t.ResourceUUID, t.Key, t.Value}
+			},
+		)
+	},
+}
+
+// parseTags parses key=value pairs as given to --tag or 'tag add'
+func parseTags(pairs []string) ([]models.Tag, error) {
+	tags := make([]models.Tag, 0, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid tag '%s': expected key=value", pair)
+		}
+		tags = append(tags, models.Tag{Key: key, Value: strings.TrimSpace(value)})
+	}
+	return tags, nil
+}
+
+// parseSelector parses a comma-separated list of key=value requirements
+func parseSelector(selector string) ([]models.Tag, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	tags, err := parseTags(strings.Split(selector, ","))
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector '%s': expected key=value[,key=value...]", selector)
+	}
+	return tags, nil
+}
+
+// matchesSelector reports whether tags satisfy every selector requirement
+func matchesSelector(tags, selector []models.Tag) bool {
+	for _, want := range selector {
+		found := false
+		for _, t := range tags {
+			if t.Key == want.Key && t.Value == want.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// formatTags renders tags as sorted key=value pairs for table output
+func formatTags(tags []models.Tag) string {
+	if len(tags) == 0 {
+		return "-"
+	}
+
+	pairs := make([]string, len(tags))
+	for i, t := range tags {
+		pairs[i] = t.Key + "=" + t.Value
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagAddCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	tagCmd.AddCommand(tagListCmd)
+
+	tagListCmd.Flags().String("type", "", "Filter by resource type (instance, network, k8s, ip)")
+	tagListCmd.Flags().String("resource", "", "Filter by resource UUID")
+}
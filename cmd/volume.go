@@ -0,0 +1,464 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// volumeCmd represents the volume command
+var volumeCmd = &cobra.Command{
+	Use:     "volume",
+	Aliases: []string{"volumes", "disk"},
+	Short:   "Manage block storage volumes",
+	Long:    `List, create, attach, resize and delete block storage volumes.`,
+}
+
+// volumeListCmd lists volumes
+var volumeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List volumes",
+	Long:  `List all volumes in a region, optionally only those attached to an instance.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+		instanceRef, _ := cmd.Flags().GetString("instance")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		var volumes []models.Volume
+		if instanceRef != "" {
+			inst, err := c.FindInstance(instanceRef, region)
+			if err != nil {
+				return err
+			}
+			volumes, err = c.ListInstanceVolumes(inst.UUID, region)
+			if err != nil {
+				return fmt.Errorf("failed to list volumes: %w", err)
+			}
+		} else {
+			volumes, err = c.ListVolumes(region)
+			if err != nil {
+				return fmt.Errorf("failed to list volumes: %w", err)
+			}
+		}
+
+		if len(volumes) == 0 {
+			output.PrintInfo("No volumes found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(volumes))
+		for i, vol := range volumes {
+			dataSlice[i] = vol
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "TYPE", "SIZE", "STATE", "ATTACHED TO", "OFFERING"},
+			volumeRow,
+		)
+	},
+}
+
+// volumeCreateCmd creates a data volume
+var volumeCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a volume",
+	Long: `Create a data volume from a disk offering.
+
+--size (in GB) is required for custom-size offerings and not allowed otherwise.
+With --instance the volume is attached as soon as it is created.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		offeringRef, _ := cmd.Flags().GetString("offering")
+		size, _ := cmd.Flags().GetInt64("size")
+		instanceRef, _ := cmd.Flags().GetString("instance")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || offeringRef == "" {
+			return fmt.Errorf("required flags: --name, --offering")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		offering, err := c.FindDiskOffering(offeringRef, region)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case offering.IsCustomDiskOffering && size <= 0:
+			return fmt.Errorf("disk offering %s has a custom size, --size is required", offering.Name)
+		case !offering.IsCustomDiskOffering && size > 0:
+			return fmt.Errorf("disk offering %s has a fixed size of %d GB, remove --size or pick a custom offering", offering.Name, offering.DiskSize)
+		}
+
+		var inst *models.Instance
+		if instanceRef != "" {
+			if inst, err = c.FindInstance(instanceRef, region); err != nil {
+				return err
+			}
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating volume '%s' (%s)...", name, offering.Name))
+
+		vol, err := c.CreateVolume(models.CreateVolumeRequest{
+			Name:             name,
+			DiskOfferingUUID: offering.UUID,
+			Size:             size,
+			Region:           region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create volume: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume created: %s (UUID: %s)", vol.Name, vol.UUID))
+
+		if inst != nil {
+			if err := c.AttachVolume(vol.UUID, inst.UUID); err != nil {
+				return err
+			}
+			output.PrintSuccess(fmt.Sprintf("Volume attached to %s", inst.Name))
+		}
+
+		return nil
+	},
+}
+
+// volumeDeleteCmd deletes a volume
+var volumeDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a volume",
+	Long: `Delete a data volume. It must be detached first; root volumes are deleted
+with their instance.
+
+You will be asked to type the volume name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if strings.EqualFold(vol.Type, "ROOT") {
+			return fmt.Errorf("%s is the root volume of %s and is deleted with the instance", vol.Name, valueOr(vol.VirtualMachineName, vol.VirtualMachineUUID))
+		}
+		if vol.VirtualMachineUUID != "" {
+			return fmt.Errorf("volume %s is attached to %s, run 'sannti volume detach %s' first", vol.Name, valueOr(vol.VirtualMachineName, vol.VirtualMachineUUID), vol.Name)
+		}
+
+		if err := confirmDestructive("volume", vol.Name, []resourceDetail{
+			{"Name", vol.Name},
+			{"UUID", vol.UUID},
+			{"Size", formatBytes(vol.Size)},
+			{"Offering", vol.DiskOfferingName},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVolume(vol.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume %s deleted successfully", vol.Name))
+		return nil
+	},
+}
+
+// volumeAttachCmd attaches a volume to an instance
+var volumeAttachCmd = &cobra.Command{
+	Use:   "attach <name-or-uuid>",
+	Short: "Attach a volume to an instance",
+	Long:  `Attach a detached data volume to an instance in the same region.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		instanceRef, _ := cmd.Flags().GetString("instance")
+		if instanceRef == "" {
+			return fmt.Errorf("required flag: --instance")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(args[0], region)
+		if err != nil {
+			return err
+		}
+		if vol.VirtualMachineUUID != "" {
+			return fmt.Errorf("volume %s is already attached to %s", vol.Name, valueOr(vol.VirtualMachineName, vol.VirtualMachineUUID))
+		}
+
+		inst, err := c.FindInstance(instanceRef, region)
+		if err != nil {
+			return err
+		}
+
+		if err := c.AttachVolume(vol.UUID, inst.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume %s attached to %s", vol.Name, inst.Name))
+		return nil
+	},
+}
+
+// volumeDetachCmd detaches a volume from its instance
+var volumeDetachCmd = &cobra.Command{
+	Use:   "detach <name-or-uuid>",
+	Short: "Detach a volume from its instance",
+	Long: `Detach a data volume from its instance. Unmount it inside the instance first
+to avoid data loss.
+
+You will be asked to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if vol.VirtualMachineUUID == "" {
+			return fmt.Errorf("volume %s is not attached", vol.Name)
+		}
+		if strings.EqualFold(vol.Type, "ROOT") {
+			return fmt.Errorf("%s is a root volume and cannot be detached", vol.Name)
+		}
+
+		instanceName := valueOr(vol.VirtualMachineName, vol.VirtualMachineUUID)
+		if err := confirmAction(fmt.Sprintf("Detach volume %s from %s?", vol.Name, instanceName)); err != nil {
+			return err
+		}
+
+		if err := c.DetachVolume(vol.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume %s detached from %s", vol.Name, instanceName))
+		return nil
+	},
+}
+
+// volumeResizeCmd grows a volume
+var volumeResizeCmd = &cobra.Command{
+	Use:   "resize <name-or-uuid>",
+	Short: "Grow a volume",
+	Long: `Grow a volume to --size gigabytes. Volumes cannot be shrunk.
+
+The filesystem inside the instance must be grown separately afterwards.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		size, _ := cmd.Flags().GetInt64("size")
+		if size <= 0 {
+			return fmt.Errorf("required flag: --size")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vol, err := c.FindVolume(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		newSize := size * bytesPerGiB
+		switch {
+		case newSize == vol.Size:
+			return fmt.Errorf("volume %s is already %s", vol.Name, formatBytes(vol.Size))
+		case newSize < vol.Size:
+			return fmt.Errorf("volume %s is %s, shrinking to %s is not supported", vol.Name, formatBytes(vol.Size), formatBytes(newSize))
+		}
+
+		output.PrintInfo(fmt.Sprintf("Resizing volume %s from %s to %s...", vol.Name, formatBytes(vol.Size), formatBytes(newSize)))
+
+		if err := c.ResizeVolume(vol.UUID, size); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Volume %s resized to %s", vol.Name, formatBytes(newSize)))
+		return nil
+	},
+}
+
+// volumeOfferingsCmd lists disk offerings
+var volumeOfferingsCmd = &cobra.Command{
+	Use:   "offerings",
+	Short: "List available disk offerings",
+	Long:  `List the disk offerings that volumes can be created from.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		offerings, err := c.ListDiskOfferings(region)
+		if err != nil {
+			return fmt.Errorf("failed to list disk offerings: %w", err)
+		}
+
+		if len(offerings) == 0 {
+			output.PrintInfo("No disk offerings found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(offerings))
+		for i, off := range offerings {
+			dataSlice[i] = off
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "SIZE", "STORAGE TYPE", "DESCRIPTION"},
+			func(item interface{}) []string {
+				off := item.(models.DiskOffering)
+				size := "custom"
+				if !off.IsCustomDiskOffering {
+					size = formatBytes(off.DiskSize * bytesPerGiB)
+				}
+				return []string{off.UUID, off.Name, size, valueOr(off.StorageType, "-"), valueOr(off.DisplayText, "-")}
+			},
+		)
+	},
+}
+
+// volumeRow renders a volume for table output
+func volumeRow(item interface{}) []string {
+	vol := item.(models.Volume)
+	attached := "-"
+	if vol.VirtualMachineUUID != "" {
+		attached = valueOr(vol.VirtualMachineName, vol.VirtualMachineUUID)
+	}
+	return []string{vol.UUID, vol.Name, vol.Type, formatBytes(vol.Size), vol.State, attached, valueOr(vol.DiskOfferingName, "-")}
+}
+
+// bytesPerGiB converts the GB sizes used by the API into bytes
+const bytesPerGiB = 1 << 30
+
+// formatBytes renders a byte count in human-readable binary units
+func formatBytes(n int64) string {
+	if n <= 0 {
+		return "-"
+	}
+
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value := float64(n)
+	suffixes := []string{"KiB", "MiB", "GiB", "TiB", "PiB"}
+	i := -1
+	for value >= unit && i < len(suffixes)-1 {
+		value /= unit
+		i++
+	}
+
+	if value == float64(int64(value)) {
+		return fmt.Sprintf("%d %s", int64(value), suffixes[i])
+	}
+	return fmt.Sprintf("%.1f %s", value, suffixes[i])
+}
+
+// formatByteString renders a byte count returned by the API as a string
+func formatByteString(s string) string {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return "-"
+	}
+	return formatBytes(n)
+}
+
+func init() {
+	rootCmd.AddCommand(volumeCmd)
+	volumeCmd.AddCommand(volumeListCmd)
+	volumeCmd.AddCommand(volumeCreateCmd)
+	volumeCmd.AddCommand(volumeDeleteCmd)
+	volumeCmd.AddCommand(volumeAttachCmd)
+	volumeCmd.AddCommand(volumeDetachCmd)
+	volumeCmd.AddCommand(volumeResizeCmd)
+	volumeCmd.AddCommand(volumeOfferingsCmd)
+
+	volumeListCmd.Flags().String("instance", "", "Only show volumes attached to this instance (name or UUID)")
+
+	volumeCreateCmd.Flags().String("name", "", "Volume name (required)")
+	volumeCreateCmd.Flags().String("offering", "", "Disk offering, name or UUID (required)")
+	volumeCreateCmd.Flags().Int64("size", 0, "Size in GB (custom-size offerings only)")
+	volumeCreateCmd.Flags().String("instance", "", "Attach the new volume to this instance (name or UUID)")
+
+	volumeAttachCmd.Flags().String("instance", "", "Instance to attach to, name or UUID (required)")
+
+	volumeResizeCmd.Flags().Int64("size", 0, "New size in GB (required)")
+}
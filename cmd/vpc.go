@@ -0,0 +1,420 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// vpcCmd represents the vpc command
+var vpcCmd = &cobra.Command{
+	Use:   "vpc",
+	Short: "Manage VPCs",
+	Long: `List and manage VPCs. A VPC is an address space split into network tiers
+whose traffic is controlled by network ACLs (see 'sannti network acl').`,
+}
+
+// vpcListCmd lists VPCs
+var vpcListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List VPCs",
+	Long:  `List all VPCs in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vpcs, err := c.ListVPCs(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPCs: %w", err)
+		}
+
+		if len(vpcs) == 0 {
+			output.PrintInfo("No VPCs found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(vpcs))
+		for i, vpc := range vpcs {
+			dataSlice[i] = vpc
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "STATE", "REGION", "CIDR"},
+			func(item interface{}) []string {
+				vpc := item.(models.VPC)
+				return []string{vpc.UUID, vpc.Name, vpc.State, vpc.ZoneName, vpc.Cidr}
+			},
+		)
+	},
+}
+
+// vpcDetails is the machine-readable output of vpc get
+type vpcDetails struct {
+	*models.VPC `yaml:",inline"`
+	Tiers       []models.Network `json:"tiers" yaml:"tiers"`
+}
+
+// vpcGetCmd shows a VPC and its tiers
+var vpcGetCmd = &cobra.Command{
+	Use:   "get <name-or-uuid>",
+	Short: "Get VPC details",
+	Long:  `Show a VPC and the network tiers it contains.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vpc, err := c.FindVPC(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		tiers, err := vpcTiers(c, vpc.UUID, region)
+		if err != nil {
+			return err
+		}
+
+		if output.Format(outputFormat) != output.FormatTable {
+			return output.Print(vpcDetails{VPC: vpc, Tiers: tiers}, output.Format(outputFormat), nil, nil)
+		}
+
+		if err := output.Print(
+			vpc,
+			output.FormatTable,
+			[]string{"UUID", "NAME", "STATE", "REGION", "CIDR", "DOMAIN"},
+			func(item interface{}) []string {
+				vpc := item.(*models.VPC)
+				return []string{vpc.UUID, vpc.Name, vpc.State, vpc.ZoneName, vpc.Cidr, valueOr(vpc.NetworkDomain, "-")}
+			},
+		); err != nil {
+			return err
+		}
+
+		fmt.Println()
+		if len(tiers) == 0 {
+			output.PrintInfo("No tiers, add one with 'sannti vpc tier create'")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(tiers))
+		for i, tier := range tiers {
+			dataSlice[i] = tier
+		}
+
+		return output.Print(
+			dataSlice,
+			output.FormatTable,
+			[]string{"TIER UUID", "NAME", "STATE", "CIDR", "GATEWAY", "ACL"},
+			func(item interface{}) []string {
+				tier := item.(models.Network)
+				return []string{tier.UUID, tier.Name, tier.State, tier.Cidr, tier.Gateway, valueOr(tier.ACLName, "-")}
+			},
+		)
+	},
+}
+
+// vpcCreateCmd creates a VPC
+var vpcCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a VPC",
+	Long: `Create a new VPC. The CIDR is the address space its tiers are carved from
+and must not overlap another VPC in the region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		cidr, _ := cmd.Flags().GetString("cidr")
+		domain, _ := cmd.Flags().GetString("domain")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || cidr == "" {
+			return fmt.Errorf("required flags: --name, --cidr")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		existing, err := c.ListVPCs(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPCs: %w", err)
+		}
+
+		ipNet, err := validateVPCCIDR(cidr, existing)
+		if err != nil {
+			return err
+		}
+
+		if description == "" {
+			description = name
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating VPC '%s' (%s) in region '%s'...", name, ipNet, region))
+
+		vpc, err := c.CreateVPC(models.CreateVPCRequest{
+			Name:          name,
+			Description:   description,
+			Cidr:          ipNet.String(),
+			NetworkDomain: domain,
+			Region:        region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create VPC: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPC created: %s (UUID: %s)", vpc.Name, vpc.UUID))
+		return nil
+	},
+}
+
+// vpcDeleteCmd deletes a VPC
+var vpcDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a VPC",
+	Long: `Delete a VPC. All of its tiers must be deleted first.
+
+You will be asked to type the VPC name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vpc, err := c.FindVPC(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		tiers, err := vpcTiers(c, vpc.UUID, region)
+		if err != nil {
+			return err
+		}
+		if len(tiers) > 0 {
+			return fmt.Errorf("VPC %s still has %d tier(s), delete them first with 'sannti network delete'", vpc.Name, len(tiers))
+		}
+
+		if err := confirmDestructive("VPC", vpc.Name, []resourceDetail{
+			{"Name", vpc.Name},
+			{"UUID", vpc.UUID},
+			{"Region", vpc.ZoneName},
+			{"CIDR", vpc.Cidr},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVPC(vpc.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPC %s deleted successfully", vpc.Name))
+		return nil
+	},
+}
+
+// vpcTierCmd groups VPC tier commands
+var vpcTierCmd = &cobra.Command{
+	Use:   "tier",
+	Short: "Manage VPC tiers",
+	Long:  `Manage the network tiers (subnets) of a VPC. Tiers are deleted with 'sannti network delete'.`,
+}
+
+// vpcTierCreateCmd adds a tier to a VPC
+var vpcTierCreateCmd = &cobra.Command{
+	Use:   "create <vpc>",
+	Short: "Add a tier to a VPC",
+	Long: `Create a network tier inside a VPC.
+
+The tier CIDR must lie within the VPC CIDR and not overlap other tiers. The
+gateway defaults to the first usable address. --acl applies a network ACL to
+the tier; without it the platform default ACL is used.
+
+Example:
+  sannti vpc tier create prod --name web --cidr 10.10.1.0/24 --offering vpc-tier --acl web-acl`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		cidr, _ := cmd.Flags().GetString("cidr")
+		gateway, _ := cmd.Flags().GetString("gateway")
+		offeringRef, _ := cmd.Flags().GetString("offering")
+		aclRef, _ := cmd.Flags().GetString("acl")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || cidr == "" || offeringRef == "" {
+			return fmt.Errorf("required flags: --name, --cidr, --offering")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		vpc, err := c.FindVPC(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		tiers, err := vpcTiers(c, vpc.UUID, region)
+		if err != nil {
+			return err
+		}
+
+		ipNet, gateway, err := validateNetworkCIDR(cidr, gateway, tiers)
+		if err != nil {
+			return err
+		}
+
+		if _, vpcNet, err := net.ParseCIDR(vpc.Cidr); err == nil {
+			vpcOnes, _ := vpcNet.Mask.Size()
+			tierOnes, _ := ipNet.Mask.Size()
+			if !vpcNet.Contains(ipNet.IP) || tierOnes < vpcOnes {
+				return fmt.Errorf("tier CIDR %s is not within VPC %s (%s)", ipNet, vpc.Name, vpc.Cidr)
+			}
+		}
+
+		offering, err := c.FindNetworkOffering(offeringRef, region)
+		if err != nil {
+			return err
+		}
+
+		req := models.CreateNetworkRequest{
+			Name:                name,
+			DisplayText:         name,
+			Region:              region,
+			NetworkOfferingUUID: offering.UUID,
+			Cidr:                ipNet.String(),
+			Gateway:             gateway,
+			Netmask:             net.IP(ipNet.Mask).String(),
+			VpcUUID:             vpc.UUID,
+		}
+
+		if aclRef != "" {
+			acl, err := c.FindNetworkACL(aclRef, vpc.UUID)
+			if err != nil {
+				return err
+			}
+			req.ACLUUID = acl.UUID
+		}
+
+		output.PrintInfo(fmt.Sprintf("Creating tier '%s' (%s) in VPC %s...", name, ipNet, vpc.Name))
+
+		tier, err := c.CreateNetwork(req)
+		if err != nil {
+			return fmt.Errorf("failed to create tier: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Tier created: %s (UUID: %s)", tier.Name, tier.UUID))
+		return nil
+	},
+}
+
+// vpcTiers returns the networks that belong to a VPC
+func vpcTiers(c *client.Client, vpcUUID, region string) ([]models.Network, error) {
+	networks, err := c.ListNetworks(region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	var tiers []models.Network
+	for _, n := range networks {
+		if n.VpcUUID == vpcUUID {
+			tiers = append(tiers, n)
+		}
+	}
+	return tiers, nil
+}
+
+// validateVPCCIDR checks that a VPC CIDR is a valid IPv4 network that does
+// not overlap another VPC
+func validateVPCCIDR(cidr string, existing []models.VPC) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("invalid CIDR '%s': only IPv4 VPCs are supported", cidr)
+	}
+	if !ip.Equal(ipNet.IP) {
+		return nil, fmt.Errorf("invalid CIDR '%s': host bits are set, did you mean %s?", cidr, ipNet)
+	}
+	if ones, _ := ipNet.Mask.Size(); ones > 28 {
+		return nil, fmt.Errorf("invalid CIDR '%s': prefix must be /28 or larger", cidr)
+	}
+
+	for _, vpc := range existing {
+		_, other, err := net.ParseCIDR(vpc.Cidr)
+		if err != nil {
+			continue
+		}
+		if other.Contains(ipNet.IP) || ipNet.Contains(other.IP) {
+			return nil, fmt.Errorf("CIDR %s overlaps VPC '%s' (%s)", ipNet, vpc.Name, vpc.Cidr)
+		}
+	}
+
+	return ipNet, nil
+}
+
+func init() {
+	rootCmd.AddCommand(vpcCmd)
+	vpcCmd.AddCommand(vpcListCmd)
+	vpcCmd.AddCommand(vpcGetCmd)
+	vpcCmd.AddCommand(vpcCreateCmd)
+	vpcCmd.AddCommand(vpcDeleteCmd)
+	vpcCmd.AddCommand(vpcTierCmd)
+	vpcTierCmd.AddCommand(vpcTierCreateCmd)
+
+	vpcCreateCmd.Flags().String("name", "", "VPC name (required)")
+	vpcCreateCmd.Flags().String("description", "", "VPC description (defaults to the name)")
+	vpcCreateCmd.Flags().String("cidr", "", "VPC address space, e.g. 10.10.0.0/16 (required)")
+	vpcCreateCmd.Flags().String("domain", "", "DNS domain for instances in the VPC")
+
+	vpcTierCreateCmd.Flags().String("name", "", "Tier name (required)")
+	vpcTierCreateCmd.Flags().String("cidr", "", "Tier CIDR within the VPC, e.g. 10.10.1.0/24 (required)")
+	vpcTierCreateCmd.Flags().String("gateway", "", "Gateway address (defaults to the first usable address)")
+	vpcTierCreateCmd.Flags().String("offering", "", "VPC tier network offering, name or UUID (required)")
+	vpcTierCreateCmd.Flags().String("acl", "", "Network ACL to apply, name or UUID")
+}
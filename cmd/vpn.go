@@ -0,0 +1,841 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/config"
+	"github.com/sannticloud/sannti-cli/internal/models"
+	"github.com/sannticloud/sannti-cli/internal/output"
+	"golang.org/x/term"
+)
+
+// vpnCmd represents the vpn command
+var vpnCmd = &cobra.Command{
+	Use:   "vpn",
+	Short: "Manage site-to-site VPNs",
+	Long: `Manage site-to-site VPN tunnels between VPCs and remote networks.
+
+A tunnel needs three pieces:
+  gateway           the VPN endpoint of a VPC
+  customer-gateway  the remote device, its networks and IPsec settings
+  connection        the tunnel between the two`,
+}
+
+// vpnGatewayCmd groups VPN gateway commands
+var vpnGatewayCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "Manage VPC VPN gateways",
+	Long:  `List, create and delete the VPN gateways of VPCs.`,
+}
+
+// vpnGatewayListCmd lists VPN gateways
+var vpnGatewayListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List VPN gateways",
+	Long:  `List the VPN gateways in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		gateways, err := c.ListVPNGateways(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN gateways: %w", err)
+		}
+
+		if len(gateways) == 0 {
+			output.PrintInfo("No VPN gateways found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(gateways))
+		for i, gw := range gateways {
+			dataSlice[i] = gw
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "VPC", "PUBLIC IP", "STATE"},
+			func(item interface{}) []string {
+				gw := item.(models.VPNGateway)
+				return []string{gw.UUID, valueOr(gw.VpcName, gw.VpcUUID), gw.PublicIP, gw.State}
+			},
+		)
+	},
+}
+
+// vpnGatewayCreateCmd creates a VPN gateway
+var vpnGatewayCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a VPN gateway for a VPC",
+	Long:  `Create the VPN gateway of a VPC. Each VPC has at most one gateway.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		vpcRef, _ := cmd.Flags().GetString("vpc")
+		if vpcRef == "" {
+			return fmt.Errorf("required flag: --vpc")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		vpc, err := c.FindVPC(vpcRef, region)
+		if err != nil {
+			return err
+		}
+
+		gateways, err := c.ListVPNGateways(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN gateways: %w", err)
+		}
+		for _, gw := range gateways {
+			if gw.VpcUUID == vpc.UUID {
+				return fmt.Errorf("VPC %s already has VPN gateway %s (%s)", vpc.Name, gw.UUID, gw.PublicIP)
+			}
+		}
+
+		gw, err := c.CreateVPNGateway(vpc.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to create VPN gateway: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPN gateway created for VPC %s: %s (UUID: %s)", vpc.Name, gw.PublicIP, gw.UUID))
+		return nil
+	},
+}
+
+// vpnGatewayDeleteCmd deletes a VPN gateway
+var vpnGatewayDeleteCmd = &cobra.Command{
+	Use:   "delete <uuid-or-vpc>",
+	Short: "Delete a VPN gateway",
+	Long: `Delete a VPN gateway. Its connections must be deleted first.
+
+You will be asked to type the gateway UUID to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		gw, err := c.FindVPNGateway(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		conns, err := c.ListVPNConnections(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN connections: %w", err)
+		}
+		for _, conn := range conns {
+			if conn.VPNGatewayUUID == gw.UUID {
+				return fmt.Errorf("VPN gateway %s still has connection %s, delete it first", gw.UUID, conn.UUID)
+			}
+		}
+
+		if err := confirmDestructiveUUID("VPN gateway", gw.UUID, []resourceDetail{
+			{"UUID", gw.UUID},
+			{"VPC", gw.VpcName},
+			{"Public IP", gw.PublicIP},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVPNGateway(gw.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPN gateway %s deleted successfully", gw.UUID))
+		return nil
+	},
+}
+
+// vpnCustomerGatewayCmd groups customer gateway commands
+var vpnCustomerGatewayCmd = &cobra.Command{
+	Use:     "customer-gateway",
+	Aliases: []string{"cgw"},
+	Short:   "Manage VPN customer gateways",
+	Long:    `List, create and delete customer gateways, the remote ends of VPN tunnels.`,
+}
+
+// vpnCustomerGatewayListCmd lists customer gateways
+var vpnCustomerGatewayListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List customer gateways",
+	Long:  `List the customer gateways in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		gateways, err := c.ListVPNCustomerGateways(region)
+		if err != nil {
+			return fmt.Errorf("failed to list customer gateways: %w", err)
+		}
+
+		if len(gateways) == 0 {
+			output.PrintInfo("No customer gateways found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(gateways))
+		for i, gw := range gateways {
+			dataSlice[i] = gw
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "NAME", "GATEWAY", "CIDRS", "IKE", "ESP", "VERSION"},
+			func(item interface{}) []string {
+				gw := item.(models.VPNCustomerGateway)
+				return []string{gw.UUID, gw.Name, gw.Gateway, gw.CidrList, gw.IKEPolicy, gw.ESPPolicy, valueOr(gw.IKEVersion, "ike")}
+			},
+		)
+	},
+}
+
+// vpnCustomerGatewayCreateCmd registers a customer gateway
+var vpnCustomerGatewayCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a customer gateway",
+	Long: `Register the remote end of a VPN tunnel.
+
+IKE and ESP policies use the form <encryption>-<hash>[;<dh-group>], e.g.
+aes256-sha256;modp2048. Several proposals may be separated by commas.
+  encryption: 3des, aes128, aes192, aes256
+  hash:       md5, sha1, sha256, sha384, sha512
+  dh-group:   modp1024, modp1536, modp2048, modp3072, modp4096, modp6144, modp8192
+The DH group is required for IKE and enables perfect forward secrecy for ESP.
+
+The pre-shared key is read from --psk, the SANNTI_VPN_PSK environment
+variable, or prompted for without echo.
+
+Example:
+  sannti vpn customer-gateway create --name office --gateway 198.51.100.7 \
+    --cidr 192.168.0.0/16 --ike-policy "aes256-sha256;modp2048" --esp-policy aes256-sha256`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		gateway, _ := cmd.Flags().GetString("gateway")
+		cidrs, _ := cmd.Flags().GetStringSlice("cidr")
+		psk, _ := cmd.Flags().GetString("psk")
+		ikePolicy, _ := cmd.Flags().GetString("ike-policy")
+		espPolicy, _ := cmd.Flags().GetString("esp-policy")
+		ikeLifetime, _ := cmd.Flags().GetInt("ike-lifetime")
+		espLifetime, _ := cmd.Flags().GetInt("esp-lifetime")
+		ikeVersion, _ := cmd.Flags().GetString("ike-version")
+		dpd, _ := cmd.Flags().GetBool("dpd")
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		if name == "" || gateway == "" || len(cidrs) == 0 {
+			return fmt.Errorf("required flags: --name, --gateway, --cidr")
+		}
+
+		if ip := net.ParseIP(gateway); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid gateway '%s': must be the public IPv4 address of the remote device", gateway)
+		}
+
+		normalized, err := validateVPNCIDRs(cidrs)
+		if err != nil {
+			return err
+		}
+
+		if err := validateVPNPolicy("IKE", ikePolicy, true); err != nil {
+			return err
+		}
+		if err := validateVPNPolicy("ESP", espPolicy, false); err != nil {
+			return err
+		}
+
+		if ikeLifetime < 60 || ikeLifetime > 86400 {
+			return fmt.Errorf("invalid IKE lifetime %d: must be between 60 and 86400 seconds", ikeLifetime)
+		}
+		if espLifetime < 60 || espLifetime > 86400 {
+			return fmt.Errorf("invalid ESP lifetime %d: must be between 60 and 86400 seconds", espLifetime)
+		}
+		if espLifetime > ikeLifetime {
+			return fmt.Errorf("ESP lifetime (%ds) must not exceed IKE lifetime (%ds)", espLifetime, ikeLifetime)
+		}
+
+		switch ikeVersion {
+		case "ike", "ikev1", "ikev2":
+		default:
+			return fmt.Errorf("invalid IKE version '%s': must be ike, ikev1 or ikev2", ikeVersion)
+		}
+
+		if psk == "" {
+			if psk, err = readVPNPreSharedKey(); err != nil {
+				return err
+			}
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		gw, err := c.CreateVPNCustomerGateway(models.CreateVPNCustomerGatewayRequest{
+			Name:        name,
+			Gateway:     gateway,
+			CidrList:    strings.Join(normalized, ","),
+			IPSecPSK:    psk,
+			IKEPolicy:   ikePolicy,
+			ESPPolicy:   espPolicy,
+			IKELifetime: ikeLifetime,
+			ESPLifetime: espLifetime,
+			IKEVersion:  ikeVersion,
+			DPD:         dpd,
+			Region:      region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create customer gateway: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Customer gateway created: %s (UUID: %s)", gw.Name, gw.UUID))
+		return nil
+	},
+}
+
+// vpnCustomerGatewayDeleteCmd deletes a customer gateway
+var vpnCustomerGatewayDeleteCmd = &cobra.Command{
+	Use:   "delete <name-or-uuid>",
+	Short: "Delete a customer gateway",
+	Long: `Delete a customer gateway. Its connections must be deleted first.
+
+You will be asked to type the gateway name to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		gw, err := c.FindVPNCustomerGateway(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		conns, err := c.ListVPNConnections(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN connections: %w", err)
+		}
+		for _, conn := range conns {
+			if conn.CustomerGatewayUUID == gw.UUID {
+				return fmt.Errorf("customer gateway %s still has connection %s, delete it first", gw.Name, conn.UUID)
+			}
+		}
+
+		if err := confirmDestructive("customer gateway", gw.Name, []resourceDetail{
+			{"Name", gw.Name},
+			{"UUID", gw.UUID},
+			{"Gateway", gw.Gateway},
+			{"CIDRs", gw.CidrList},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVPNCustomerGateway(gw.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("Customer gateway %s deleted successfully", gw.Name))
+		return nil
+	},
+}
+
+// vpnConnectionCmd groups VPN connection commands
+var vpnConnectionCmd = &cobra.Command{
+	Use:     "connection",
+	Aliases: []string{"conn"},
+	Short:   "Manage VPN connections",
+	Long:    `List, create, reset and delete site-to-site VPN tunnels.`,
+}
+
+// vpnConnectionListCmd lists VPN connections
+var vpnConnectionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List VPN connections",
+	Long:  `List the site-to-site VPN connections in a region.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		conns, err := c.ListVPNConnections(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN connections: %w", err)
+		}
+
+		if len(conns) == 0 {
+			output.PrintInfo("No VPN connections found")
+			return nil
+		}
+
+		dataSlice := make([]interface{}, len(conns))
+		for i, conn := range conns {
+			dataSlice[i] = conn
+		}
+
+		return output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"UUID", "VPN GATEWAY", "CUSTOMER GATEWAY", "STATE", "PASSIVE"},
+			func(item interface{}) []string {
+				conn := item.(models.VPNConnection)
+				return []string{conn.UUID, conn.PublicIP, valueOr(conn.CustomerGatewayName, conn.CustomerGatewayUUID), conn.State, fmt.Sprintf("%t", conn.Passive)}
+			},
+		)
+	},
+}
+
+// vpnConnectionCreateCmd creates a VPN connection
+var vpnConnectionCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a VPN connection",
+	Long: `Connect a VPC VPN gateway to a customer gateway.
+
+The remote networks of the customer gateway must not overlap the VPC CIDR.
+With --passive the tunnel waits for the remote side to initiate.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		gatewayRef, _ := cmd.Flags().GetString("gateway")
+		customerRef, _ := cmd.Flags().GetString("customer-gateway")
+		passive, _ := cmd.Flags().GetBool("passive")
+
+		if gatewayRef == "" || customerRef == "" {
+			return fmt.Errorf("required flags: --gateway, --customer-gateway")
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		gw, err := c.FindVPNGateway(gatewayRef, region)
+		if err != nil {
+			return err
+		}
+
+		customer, err := c.FindVPNCustomerGateway(customerRef, region)
+		if err != nil {
+			return err
+		}
+
+		vpc, err := c.FindVPC(gw.VpcUUID, region)
+		if err != nil {
+			return err
+		}
+		if err := checkVPNCIDROverlap(vpc, customer); err != nil {
+			return err
+		}
+
+		output.PrintInfo(fmt.Sprintf("Connecting VPC %s (%s) to %s (%s)...", vpc.Name, gw.PublicIP, customer.Name, customer.Gateway))
+
+		conn, err := c.CreateVPNConnection(models.CreateVPNConnectionRequest{
+			VPNGatewayUUID:      gw.UUID,
+			CustomerGatewayUUID: customer.UUID,
+			Passive:             passive,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create VPN connection: %w", err)
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPN connection created (UUID: %s, state: %s)", conn.UUID, conn.State))
+		output.PrintInfo("Check the tunnel with 'sannti vpn status'")
+		return nil
+	},
+}
+
+// vpnConnectionResetCmd resets a VPN connection
+var vpnConnectionResetCmd = &cobra.Command{
+	Use:   "reset <uuid>",
+	Short: "Reset a VPN connection",
+	Long: `Tear down and re-establish a VPN tunnel. Traffic through the tunnel is
+interrupted while it renegotiates.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		conn, err := c.FindVPNConnection(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmAction(fmt.Sprintf("Reset VPN connection to %s? The tunnel will be down while it renegotiates.", valueOr(conn.CustomerGatewayName, conn.Gateway))); err != nil {
+			return err
+		}
+
+		if err := c.ResetVPNConnection(conn.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPN connection %s reset", conn.UUID))
+		return nil
+	},
+}
+
+// vpnConnectionDeleteCmd deletes a VPN connection
+var vpnConnectionDeleteCmd = &cobra.Command{
+	Use:   "delete <uuid>",
+	Short: "Delete a VPN connection",
+	Long: `Delete a VPN connection. The gateways are kept.
+
+You will be asked to type the connection UUID to confirm. Use --yes to skip the prompt.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		conn, err := c.FindVPNConnection(args[0], region)
+		if err != nil {
+			return err
+		}
+
+		if err := confirmDestructiveUUID("VPN connection", conn.UUID, []resourceDetail{
+			{"UUID", conn.UUID},
+			{"VPN gateway", conn.PublicIP},
+			{"Customer", valueOr(conn.CustomerGatewayName, conn.CustomerGatewayUUID)},
+			{"Remote", conn.Gateway},
+			{"State", conn.State},
+		}); err != nil {
+			return err
+		}
+
+		if err := c.DeleteVPNConnection(conn.UUID); err != nil {
+			return err
+		}
+
+		output.PrintSuccess(fmt.Sprintf("VPN connection %s deleted successfully", conn.UUID))
+		return nil
+	},
+}
+
+// vpnTunnelStatus is one row of vpn status
+type vpnTunnelStatus struct {
+	ConnectionUUID  string `json:"connectionUuid" yaml:"connectionUuid"`
+	VPC             string `json:"vpc" yaml:"vpc"`
+	LocalIP         string `json:"localIp" yaml:"localIp"`
+	CustomerGateway string `json:"customerGateway" yaml:"customerGateway"`
+	RemoteIP        string `json:"remoteIp" yaml:"remoteIp"`
+	RemoteCidrs     string `json:"remoteCidrs" yaml:"remoteCidrs"`
+	State           string `json:"state" yaml:"state"`
+}
+
+// vpnStatusCmd shows the state of every tunnel
+var vpnStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show VPN tunnel status",
+	Long:  `Show every site-to-site tunnel in a region with its endpoints and current state.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := client.NewClient(cfg.AccessKey, cfg.SecretKey)
+
+		region := regionFlag
+		if region == "" {
+			region = cfg.DefaultRegion
+		}
+
+		conns, err := c.ListVPNConnections(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN connections: %w", err)
+		}
+
+		if len(conns) == 0 {
+			output.PrintInfo("No VPN connections found")
+			return nil
+		}
+
+		gateways, err := c.ListVPNGateways(region)
+		if err != nil {
+			return fmt.Errorf("failed to list VPN gateways: %w", err)
+		}
+		vpcByGateway := make(map[string]string, len(gateways))
+		for _, gw := range gateways {
+			vpcByGateway[gw.UUID] = valueOr(gw.VpcName, gw.VpcUUID)
+		}
+
+		connected := 0
+		dataSlice := make([]interface{}, len(conns))
+		for i, conn := range conns {
+			if strings.EqualFold(conn.State, "Connected") {
+				connected++
+			}
+			dataSlice[i] = vpnTunnelStatus{
+				ConnectionUUID:  conn.UUID,
+				VPC:             valueOr(vpcByGateway[conn.VPNGatewayUUID], "-"),
+				LocalIP:         conn.PublicIP,
+				CustomerGateway: valueOr(conn.CustomerGatewayName, conn.CustomerGatewayUUID),
+				RemoteIP:        conn.Gateway,
+				RemoteCidrs:     conn.CidrList,
+				State:           conn.State,
+			}
+		}
+
+		if err := output.Print(
+			dataSlice,
+			output.Format(outputFormat),
+			[]string{"CONNECTION", "VPC", "LOCAL IP", "CUSTOMER GATEWAY", "REMOTE IP", "REMOTE CIDRS", "STATE"},
+			func(item interface{}) []string {
+				s := item.(vpnTunnelStatus)
+				return []string{s.ConnectionUUID, s.VPC, s.LocalIP, s.CustomerGateway, s.RemoteIP, s.RemoteCidrs, s.State}
+			},
+		); err != nil {
+			return err
+		}
+
+		if output.Format(outputFormat) == output.FormatTable {
+			fmt.Println()
+			output.PrintInfo(fmt.Sprintf("%d of %d tunnel(s) connected", connected, len(conns)))
+		}
+
+		return nil
+	},
+}
+
+// vpnEncryptions, vpnHashes and vpnDHGroups are the accepted IPsec algorithms
+var (
+	vpnEncryptions = []string{"3des", "aes128", "aes192", "aes256"}
+	vpnHashes      = []string{"md5", "sha1", "sha256", "sha384", "sha512"}
+	vpnDHGroups    = []string{"modp1024", "modp1536", "modp2048", "modp3072", "modp4096", "modp6144", "modp8192"}
+)
+
+// validateVPNPolicy checks an IKE or ESP policy string such as
+// "aes256-sha256;modp2048". IKE policies must name a DH group.
+func validateVPNPolicy(kind, policy string, requireDH bool) error {
+	if policy == "" {
+		return fmt.Errorf("%s policy is required", kind)
+	}
+
+	for _, proposal := range strings.Split(policy, ",") {
+		cipher, group, hasGroup := strings.Cut(strings.TrimSpace(proposal), ";")
+		enc, hash, ok := strings.Cut(cipher, "-")
+		if !ok {
+			return fmt.Errorf("invalid %s policy '%s': expected <encryption>-<hash>[;<dh-group>]", kind, proposal)
+		}
+
+		if !containsString(vpnEncryptions, enc) {
+			return fmt.Errorf("invalid %s policy '%s': unknown encryption '%s' (use %s)", kind, proposal, enc, strings.Join(vpnEncryptions, ", "))
+		}
+		if !containsString(vpnHashes, hash) {
+			return fmt.Errorf("invalid %s policy '%s': unknown hash '%s' (use %s)", kind, proposal, hash, strings.Join(vpnHashes, ", "))
+		}
+
+		if !hasGroup {
+			if requireDH {
+				return fmt.Errorf("invalid %s policy '%s': a DH group is required, e.g. %s;modp2048", kind, proposal, cipher)
+			}
+			continue
+		}
+		if !containsString(vpnDHGroups, group) {
+			return fmt.Errorf("invalid %s policy '%s': unknown DH group '%s' (use %s)", kind, proposal, group, strings.Join(vpnDHGroups, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateVPNCIDRs checks the remote networks of a customer gateway
+func validateVPNCIDRs(cidrs []string) ([]string, error) {
+	normalized, err := normalizeCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cidr := range normalized {
+		ip, ipNet, _ := net.ParseCIDR(cidr)
+		if ip.To4() == nil {
+			return nil, fmt.Errorf("invalid CIDR '%s': only IPv4 networks are supported", cidr)
+		}
+		if ones, _ := ipNet.Mask.Size(); ones == 0 {
+			return nil, fmt.Errorf("invalid CIDR '%s': routing all traffic through a VPN is not supported", cidr)
+		}
+		for _, other := range normalized[i+1:] {
+			_, otherNet, _ := net.ParseCIDR(other)
+			if ipNet.Contains(otherNet.IP) || otherNet.Contains(ipNet.IP) {
+				return nil, fmt.Errorf("CIDRs %s and %s overlap", cidr, other)
+			}
+		}
+	}
+
+	return normalized, nil
+}
+
+// checkVPNCIDROverlap rejects tunnels whose remote networks overlap the VPC
+func checkVPNCIDROverlap(vpc *models.VPC, customer *models.VPNCustomerGateway) error {
+	_, vpcNet, err := net.ParseCIDR(vpc.Cidr)
+	if err != nil {
+		return nil
+	}
+
+	for _, cidr := range strings.Split(customer.CidrList, ",") {
+		_, remote, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			continue
+		}
+		if vpcNet.Contains(remote.IP) || remote.Contains(vpcNet.IP) {
+			return fmt.Errorf("remote network %s of %s overlaps VPC %s (%s)", remote, customer.Name, vpc.Name, vpc.Cidr)
+		}
+	}
+
+	return nil
+}
+
+// readVPNPreSharedKey reads the IPsec pre-shared key from the environment or a hidden prompt
+func readVPNPreSharedKey() (string, error) {
+	if psk := os.Getenv("SANNTI_VPN_PSK"); psk != "" {
+		return psk, nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("pre-shared key required: pass --psk or set SANNTI_VPN_PSK")
+	}
+
+	fmt.Print("IPsec pre-shared key: ")
+	pskBytes, err := term.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("failed to read pre-shared key: %w", err)
+	}
+
+	psk := strings.TrimSpace(string(pskBytes))
+	if psk == "" {
+		return "", fmt.Errorf("pre-shared key cannot be empty")
+	}
+	return psk, nil
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(vpnCmd)
+	vpnCmd.AddCommand(vpnGatewayCmd)
+	vpnCmd.AddCommand(vpnCustomerGatewayCmd)
+	vpnCmd.AddCommand(vpnConnectionCmd)
+	vpnCmd.AddCommand(vpnStatusCmd)
+
+	vpnGatewayCmd.AddCommand(vpnGatewayListCmd)
+	vpnGatewayCmd.AddCommand(vpnGatewayCreateCmd)
+	vpnGatewayCmd.AddCommand(vpnGatewayDeleteCmd)
+
+	vpnCustomerGatewayCmd.AddCommand(vpnCustomerGatewayListCmd)
+	vpnCustomerGatewayCmd.AddCommand(vpnCustomerGatewayCreateCmd)
+	vpnCustomerGatewayCmd.AddCommand(vpnCustomerGatewayDeleteCmd)
+
+	vpnConnectionCmd.AddCommand(vpnConnectionListCmd)
+	vpnConnectionCmd.AddCommand(vpnConnectionCreateCmd)
+	vpnConnectionCmd.AddCommand(vpnConnectionResetCmd)
+	vpnConnectionCmd.AddCommand(vpnConnectionDeleteCmd)
+
+	vpnGatewayCreateCmd.Flags().String("vpc", "", "VPC to create the gateway for, name or UUID (required)")
+
+	vpnCustomerGatewayCreateCmd.Flags().String("name", "", "Customer gateway name (required)")
+	vpnCustomerGatewayCreateCmd.Flags().String("gateway", "", "Public IPv4 address of the remote device (required)")
+	vpnCustomerGatewayCreateCmd.Flags().StringSlice("cidr", nil, "Remote network CIDR (repeatable or comma-separated, required)")
+	vpnCustomerGatewayCreateCmd.Flags().String("psk", "", "IPsec pre-shared key (default: SANNTI_VPN_PSK or prompt)")
+	vpnCustomerGatewayCreateCmd.Flags().String("ike-policy", "aes256-sha256;modp2048", "IKE policy, <encryption>-<hash>;<dh-group>")
+	vpnCustomerGatewayCreateCmd.Flags().String("esp-policy", "aes256-sha256", "ESP policy, <encryption>-<hash>[;<dh-group>]")
+	vpnCustomerGatewayCreateCmd.Flags().Int("ike-lifetime", 86400, "IKE phase 1 lifetime in seconds")
+	vpnCustomerGatewayCreateCmd.Flags().Int("esp-lifetime", 3600, "ESP phase 2 lifetime in seconds")
+	vpnCustomerGatewayCreateCmd.Flags().String("ike-version", "ike", "IKE version: ike (auto), ikev1 or ikev2")
+	vpnCustomerGatewayCreateCmd.Flags().Bool("dpd", true, "Enable dead peer detection")
+
+	vpnConnectionCreateCmd.Flags().String("gateway", "", "VPN gateway UUID, public IP or VPC (required)")
+	vpnConnectionCreateCmd.Flags().String("customer-gateway", "", "Customer gateway name or UUID (required)")
+	vpnConnectionCreateCmd.Flags().Bool("passive", false, "Wait for the remote side to initiate the tunnel")
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateVPNPolicy(t *testing.T) {
+	tests := []struct {
+		policy    string
+		requireDH bool
+		wantErr   string
+	}{
+		{"aes256-sha256;modp2048", true, ""},
+		{"aes256-sha256;modp2048,aes128-sha1;modp1536", true, ""},
+		{"aes256-sha256;modp2048, aes128-sha1;modp1536", true, ""},
+		{" aes256-sha256 ", false, ""},
+		{"aes256-sha256", false, ""},
+		{"aes256-sha256", true, "a DH group is required"},
+		{"aes256-sha256;modp2048,aes128-sha1", true, "a DH group is required"},
+		{"aes256-sha3;modp2048", true, "unknown hash 'sha3'"},
+		{"aes256-SHA256;modp2048", true, "unknown hash 'SHA256'"},
+		{"blowfish-sha256;modp2048", true, "unknown encryption 'blowfish'"},
+		{"aes256-sha256;modp768", true, "unknown DH group 'modp768'"},
+		{"aes256 - sha256;modp2048", true, "unknown encryption 'aes256 '"},
+		{"aes256sha256", false, "expected <encryption>-<hash>"},
+		{"aes256-sha256;modp2048,", true, "expected <encryption>-<hash>"},
+		{"", true, "policy is required"},
+	}
+
+	for _, tt := range tests {
+		err := validateVPNPolicy("IKE", tt.policy, tt.requireDH)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("validateVPNPolicy(%q, %v) = %v, want no error", tt.policy, tt.requireDH, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("validateVPNPolicy(%q, %v) = %v, want an error containing %q", tt.policy, tt.requireDH, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateVPNCIDRs(t *testing.T) {
+	tests := []struct {
+		cidrs   []string
+		want    []string
+		wantErr string
+	}{
+		{[]string{"192.168.10.0/24"}, []string{"192.168.10.0/24"}, ""},
+		{[]string{"192.168.20.0/24", "192.168.10.0/24"}, []string{"192.168.10.0/24", "192.168.20.0/24"}, ""},
+		{[]string{"192.168.10.0/24, 172.16.0.0/16"}, []string{"172.16.0.0/16", "192.168.10.0/24"}, ""},
+		{[]string{"192.168.0.0/16", "192.168.10.0/24"}, nil, "overlap"},
+		{[]string{"10.0.0.0/8", "10.0.0.0/8"}, nil, "overlap"},
+		{[]string{"0.0.0.0/0"}, nil, "routing all traffic"},
+		{[]string{"fd00::/64"}, nil, "only IPv4"},
+		{[]string{"192.168.10.1/24"}, nil, "host bits are set"},
+		{[]string{"not-a-cidr"}, nil, "invalid CIDR"},
+		{nil, nil, "at least one"},
+	}
+
+	for _, tt := range tests {
+		got, err := validateVPNCIDRs(tt.cidrs)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateVPNCIDRs(%q) = %v, want an error containing %q", tt.cidrs, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("validateVPNCIDRs(%q) = %v, want no error", tt.cidrs, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("validateVPNCIDRs(%q) = %v, want %v", tt.cidrs, got, tt.want)
+		}
+	}
+}
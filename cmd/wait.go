@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sannticloud/sannti-cli/internal/client"
+	"github.com/sannticloud/sannti-cli/internal/output"
+)
+
+// imagePollInterval is how often snapshot and image state is checked while waiting
+const imagePollInterval = 5 * time.Second
+
+// waitForTemplate polls an image until it is ready to launch instances from,
+// printing download/upload progress whenever it changes
+func waitForTemplate(c *client.Client, uuid, region string, deadline time.Time) error {
+	lastStatus := ""
+	for {
+		tpl, err := c.FindTemplate(uuid, region)
+		if err != nil {
+			return err
+		}
+
+		if tpl.IsReady {
+			return nil
+		}
+
+		status := strings.ToLower(tpl.Status)
+		if strings.Contains(status, "error") || strings.Contains(status, "failed") {
+			return fmt.Errorf("image %s failed: %s", tpl.Name, tpl.Status)
+		}
+
+		if tpl.Status != "" && tpl.Status != lastStatus {
+			output.PrintInfo(fmt.Sprintf("Image %s: %s", tpl.Name, tpl.Status))
+			lastStatus = tpl.Status
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for image %s to become ready (status: %s)", tpl.Name, valueOr(tpl.Status, "unknown"))
+		}
+
+		time.Sleep(imagePollInterval)
+	}
+}
+
+// waitForSnapshot polls a volume snapshot until it is backed up
+func waitForSnapshot(c *client.Client, uuid, region string, deadline time.Time) error {
+	for {
+		snap, err := c.FindSnapshot(uuid, region)
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(snap.State) {
+		case "backedup", "ready":
+			return nil
+		case "error", "failed":
+			return fmt.Errorf("snapshot %s entered state %s", snap.Name, snap.State)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for snapshot %s (current state: %s)", snap.Name, snap.State)
+		}
+
+		time.Sleep(imagePollInterval)
+	}
+}
@@ -6,6 +6,7 @@ require (
 	github.com/olekukonko/tablewriter v0.0.5
 	github.com/spf13/cobra v1.8.0
 	github.com/spf13/viper v1.18.2
+	golang.org/x/crypto v0.16.0
 	golang.org/x/term v0.16.0
 	gopkg.in/yaml.v3 v3.0.1
 )
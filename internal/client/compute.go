@@ -63,6 +63,33 @@ return nil, fmt.Errorf("instance not found: %s", uuid)
 return &response.ListInstanceResponse[0], nil
 }
 
+// FindInstance resolves an instance by UUID or name within a region
+func (c *Client) FindInstance(nameOrUUID, regionName string) (*models.Instance, error) {
+instances, err := c.ListInstances(regionName)
+if err != nil {
+return nil, err
+}
+
+var matches []models.Instance
+for _, inst := range instances {
+if inst.UUID == nameOrUUID {
+return &inst, nil
+}
+if inst.Name == nameOrUUID {
+matches = append(matches, inst)
+}
+}
+
+switch len(matches) {
+case 0:
+return nil, fmt.Errorf("instance not found: %s", nameOrUUID)
+case 1:
+return &matches[0], nil
+default:
+return nil, fmt.Errorf("instance name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+}
+}
+
 // CreateInstance creates a new compute instance
 func (c *Client) CreateInstance(req models.CreateInstanceRequest) (*models.Instance, error) {
 zoneUUID, err := c.GetZoneUUID(req.Region)
@@ -112,9 +139,9 @@ return fmt.Errorf("failed to stop instance: %w", err)
 return nil
 }
 
-// DeleteInstance deletes an instance
-func (c *Client) DeleteInstance(uuid string) error {
-path := fmt.Sprintf("/instance/destroyInstance?uuid=%s&expunge=true", url.QueryEscape(uuid))
+// DeleteInstance deletes an instance, expunging it immediately when requested
+func (c *Client) DeleteInstance(uuid string, expunge bool) error {
+path := fmt.Sprintf("/instance/destroyInstance?uuid=%s&expunge=%t", url.QueryEscape(uuid), expunge)
 
 _, err := c.Get(path)
 if err != nil {
@@ -124,6 +151,51 @@ return fmt.Errorf("failed to delete instance: %w", err)
 return nil
 }
 
+// GetInstanceConsole requests a web console session for an instance
+func (c *Client) GetInstanceConsole(uuid string) (*models.InstanceConsole, error) {
+path := fmt.Sprintf("/instance/consoleUrl?uuid=%s", url.QueryEscape(uuid))
+
+respBody, err := c.Get(path)
+if err != nil {
+return nil, fmt.Errorf("failed to get instance console: %w", err)
+}
+
+var console models.InstanceConsole
+if err := json.Unmarshal(respBody, &console); err != nil {
+return nil, fmt.Errorf("failed to parse instance console response: %w", err)
+}
+
+if console.URL == "" {
+return nil, fmt.Errorf("no console URL returned from API")
+}
+
+return &console, nil
+}
+
+// ResetInstancePassword resets the password of an instance and returns the new one
+func (c *Client) ResetInstancePassword(uuid string) (string, error) {
+path := fmt.Sprintf("/instance/resetPassword?uuid=%s", url.QueryEscape(uuid))
+
+respBody, err := c.Get(path)
+if err != nil {
+return "", fmt.Errorf("failed to reset instance password: %w", err)
+}
+
+var response struct {
+Password string `json:"password"`
+}
+
+if err := json.Unmarshal(respBody, &response); err != nil {
+return "", fmt.Errorf("failed to parse reset password response: %w", err)
+}
+
+if response.Password == "" {
+return "", fmt.Errorf("no password returned from API")
+}
+
+return response.Password, nil
+}
+
 // ListComputeOfferings retrieves available compute offerings (sizes)
 func (c *Client) ListComputeOfferings(regionName string) ([]models.ComputeOffering, error) {
 path := "/compute/computeOfferingList"
@@ -185,3 +257,19 @@ return nil, fmt.Errorf("failed to parse templates response: %w", err)
 
 return response.ListTemplateResponse, nil
 }
+
+// FindComputeOffering resolves a compute offering by UUID or name within a region
+func (c *Client) FindComputeOffering(nameOrUUID, regionName string) (*models.ComputeOffering, error) {
+offerings, err := c.ListComputeOfferings(regionName)
+if err != nil {
+return nil, err
+}
+
+for _, off := range offerings {
+if off.UUID == nameOrUUID || off.Name == nameOrUUID {
+return &off, nil
+}
+}
+
+return nil, fmt.Errorf("compute size '%s' not found. Run 'sannti compute sizes' for available sizes", nameOrUUID)
+}
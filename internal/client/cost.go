@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// EstimateInstanceCost prices a number of instances of a compute offering
+func (c *Client) EstimateInstanceCost(req models.CostEstimateRequest) (*models.CostEstimate, error) {
+	return c.estimateCost("/costestimate/instance", req)
+}
+
+// EstimateKubernetesCost prices a Kubernetes cluster
+func (c *Client) EstimateKubernetesCost(req models.CostEstimateRequest) (*models.CostEstimate, error) {
+	return c.estimateCost("/costestimate/kubernetes", req)
+}
+
+func (c *Client) estimateCost(path string, req models.CostEstimateRequest) (*models.CostEstimate, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post(path, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate cost: %w", err)
+	}
+
+	var estimate models.CostEstimate
+	if err := json.Unmarshal(respBody, &estimate); err != nil {
+		return nil, fmt.Errorf("failed to parse cost estimate response: %w", err)
+	}
+
+	return &estimate, nil
+}
+
+// ListComputeOfferingPrices retrieves the list prices of the compute offerings in a region
+func (c *Client) ListComputeOfferingPrices(regionName string) ([]models.ComputeOfferingPrice, error) {
+	path := "/costestimate/computeOfferingPriceList"
+
+	if regionName == "" {
+		return nil, fmt.Errorf("region is required for listing compute prices")
+	}
+
+	zoneUUID, err := c.GetZoneUUID(regionName)
+	if err != nil {
+		return nil, err
+	}
+	path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListComputeOfferingPriceResponse []models.ComputeOfferingPrice `json:"listComputeOfferingPriceResponse"`
+		Count                            int                           `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse compute prices response: %w", err)
+	}
+
+	return response.ListComputeOfferingPriceResponse, nil
+}
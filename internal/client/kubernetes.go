@@ -94,3 +94,218 @@ return fmt.Errorf("failed to delete kubernetes cluster: %w", err)
 
 return nil
 }
+
+// FindKubernetesVersion resolves a Kubernetes version by UUID or name within a region
+func (c *Client) FindKubernetesVersion(nameOrUUID, regionName string) (*models.KubernetesVersion, error) {
+versions, err := c.ListKubernetesVersions(regionName)
+if err != nil {
+return nil, err
+}
+
+for _, v := range versions {
+if v.UUID == nameOrUUID || v.Name == nameOrUUID {
+return &v, nil
+}
+}
+
+return nil, fmt.Errorf("kubernetes version '%s' not found. Run 'sannti k8s versions' for available versions", nameOrUUID)
+}
+
+// FindKubernetesCluster resolves a Kubernetes cluster by UUID or name
+func (c *Client) FindKubernetesCluster(nameOrUUID string) (*models.KubernetesCluster, error) {
+clusters, err := c.ListKubernetesClusters("")
+if err != nil {
+return nil, err
+}
+
+var matches []models.KubernetesCluster
+for _, cluster := range clusters {
+if cluster.UUID == nameOrUUID {
+return &cluster, nil
+}
+if cluster.Name == nameOrUUID {
+matches = append(matches, cluster)
+}
+}
+
+switch len(matches) {
+case 0:
+return nil, fmt.Errorf("kubernetes cluster not found: %s", nameOrUUID)
+case 1:
+return &matches[0], nil
+default:
+return nil, fmt.Errorf("kubernetes cluster name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+}
+}
+
+// GetKubernetesConfig retrieves the kubeconfig of a Kubernetes cluster
+func (c *Client) GetKubernetesConfig(clusterUUID string) (string, error) {
+path := fmt.Sprintf("/kubernetes/getKubeConfig?clusterUuid=%s", url.QueryEscape(clusterUUID))
+
+respBody, err := c.Get(path)
+if err != nil {
+return "", fmt.Errorf("failed to get kubeconfig: %w", err)
+}
+
+var response struct {
+ConfigData string `json:"configData"`
+}
+
+if err := json.Unmarshal(respBody, &response); err != nil {
+return "", fmt.Errorf("failed to parse kubeconfig response: %w", err)
+}
+
+if response.ConfigData == "" {
+return "", fmt.Errorf("no kubeconfig returned from API, the cluster may still be starting")
+}
+
+return response.ConfigData, nil
+}
+
+// ScaleKubernetesCluster changes the number of worker nodes of a cluster
+func (c *Client) ScaleKubernetesCluster(clusterUUID string, size int) error {
+path := fmt.Sprintf("/kubernetes/scaleKubernetes?clusterUuid=%s&size=%d", url.QueryEscape(clusterUUID), size)
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to scale kubernetes cluster: %w", err)
+}
+
+return nil
+}
+
+// StartKubernetesCluster starts a stopped cluster
+func (c *Client) StartKubernetesCluster(clusterUUID string) error {
+path := fmt.Sprintf("/kubernetes/startKubernetes?clusterUuid=%s", url.QueryEscape(clusterUUID))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to start kubernetes cluster: %w", err)
+}
+
+return nil
+}
+
+// StopKubernetesCluster stops a running cluster
+func (c *Client) StopKubernetesCluster(clusterUUID string) error {
+path := fmt.Sprintf("/kubernetes/stopKubernetes?clusterUuid=%s", url.QueryEscape(clusterUUID))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to stop kubernetes cluster: %w", err)
+}
+
+return nil
+}
+
+// UpgradeKubernetesCluster upgrades a cluster to another supported version
+func (c *Client) UpgradeKubernetesCluster(clusterUUID, versionUUID string) error {
+path := fmt.Sprintf(
+"/kubernetes/upgradeKubernetes?clusterUuid=%s&kubernetesSupportedVersionUuid=%s",
+url.QueryEscape(clusterUUID), url.QueryEscape(versionUUID),
+)
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to upgrade kubernetes cluster: %w", err)
+}
+
+return nil
+}
+
+// ListKubernetesNodes retrieves the control plane and worker VMs of a cluster
+func (c *Client) ListKubernetesNodes(clusterUUID string) ([]models.KubernetesNode, error) {
+path := fmt.Sprintf("/kubernetes/clusterNodeList?clusterUuid=%s", url.QueryEscape(clusterUUID))
+
+respBody, err := c.Get(path)
+if err != nil {
+return nil, err
+}
+
+var response struct {
+ListKubernetesNodeResponse []models.KubernetesNode `json:"listKubernetesNodeResponse"`
+Count                      int                     `json:"count"`
+}
+
+if err := json.Unmarshal(respBody, &response); err != nil {
+return nil, fmt.Errorf("failed to parse kubernetes nodes response: %w", err)
+}
+
+return response.ListKubernetesNodeResponse, nil
+}
+
+// ListNodePools retrieves the node pools of a cluster
+func (c *Client) ListNodePools(clusterUUID string) ([]models.KubernetesNodePool, error) {
+path := fmt.Sprintf("/kubernetes/nodePoolList?clusterUuid=%s", url.QueryEscape(clusterUUID))
+
+respBody, err := c.Get(path)
+if err != nil {
+return nil, err
+}
+
+var response struct {
+ListNodePoolResponse []models.KubernetesNodePool `json:"listNodePoolResponse"`
+Count                int                         `json:"count"`
+}
+
+if err := json.Unmarshal(respBody, &response); err != nil {
+return nil, fmt.Errorf("failed to parse node pools response: %w", err)
+}
+
+return response.ListNodePoolResponse, nil
+}
+
+// FindNodePool resolves a node pool of a cluster by UUID or name
+func (c *Client) FindNodePool(clusterUUID, nameOrUUID string) (*models.KubernetesNodePool, error) {
+pools, err := c.ListNodePools(clusterUUID)
+if err != nil {
+return nil, err
+}
+
+for _, pool := range pools {
+if pool.UUID == nameOrUUID || pool.Name == nameOrUUID {
+return &pool, nil
+}
+}
+
+return nil, fmt.Errorf("node pool not found: %s", nameOrUUID)
+}
+
+// CreateNodePool adds a node pool to a cluster
+func (c *Client) CreateNodePool(req models.CreateNodePoolRequest) (*models.KubernetesNodePool, error) {
+respBody, err := c.Post("/kubernetes/createNodePool", req)
+if err != nil {
+return nil, err
+}
+
+var pool models.KubernetesNodePool
+if err := json.Unmarshal(respBody, &pool); err != nil {
+return nil, fmt.Errorf("failed to parse create node pool response: %w", err)
+}
+
+return &pool, nil
+}
+
+// ScaleNodePool changes the number of nodes in a node pool
+func (c *Client) ScaleNodePool(nodePoolUUID string, size int) error {
+path := fmt.Sprintf("/kubernetes/scaleNodePool?nodePoolUuid=%s&size=%d", url.QueryEscape(nodePoolUUID), size)
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to scale node pool: %w", err)
+}
+
+return nil
+}
+
+// DeleteNodePool removes a node pool and its nodes from a cluster
+func (c *Client) DeleteNodePool(nodePoolUUID string) error {
+path := fmt.Sprintf("/kubernetes/deleteNodePool?nodePoolUuid=%s", url.QueryEscape(nodePoolUUID))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to delete node pool: %w", err)
+}
+
+return nil
+}
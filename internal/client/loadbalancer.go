@@ -0,0 +1,188 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListLoadBalancers retrieves the load balancer rules in a region
+func (c *Client) ListLoadBalancers(regionName string) ([]models.LoadBalancerRule, error) {
+	path := "/loadbalancer/loadBalancerRuleList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListLoadBalancerRuleResponse []models.LoadBalancerRule `json:"listLoadBalancerRuleResponse"`
+		Count                        int                       `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse load balancers response: %w", err)
+	}
+
+	return response.ListLoadBalancerRuleResponse, nil
+}
+
+// FindLoadBalancer resolves a load balancer by UUID or name within a region
+func (c *Client) FindLoadBalancer(nameOrUUID, regionName string) (*models.LoadBalancerRule, error) {
+	rules, err := c.ListLoadBalancers(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.LoadBalancerRule
+	for _, rule := range rules {
+		if rule.UUID == nameOrUUID {
+			return &rule, nil
+		}
+		if rule.Name == nameOrUUID {
+			matches = append(matches, rule)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("load balancer not found: %s. Run 'sannti lb list' for available load balancers", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("load balancer name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// ListLoadBalancerMembers retrieves the instances assigned to a load balancer
+func (c *Client) ListLoadBalancerMembers(uuid string) ([]models.LoadBalancerMember, error) {
+	path := fmt.Sprintf("/loadbalancer/loadBalancerInstanceList?uuid=%s", url.QueryEscape(uuid))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListLoadBalancerInstanceResponse []models.LoadBalancerMember `json:"listLoadBalancerInstanceResponse"`
+		Count                            int                         `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse load balancer members response: %w", err)
+	}
+
+	return response.ListLoadBalancerInstanceResponse, nil
+}
+
+// CreateLoadBalancer creates a load balancer rule on a public IP address
+func (c *Client) CreateLoadBalancer(req models.CreateLoadBalancerRequest) (*models.LoadBalancerRule, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/loadbalancer/createLoadBalancerRule", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule models.LoadBalancerRule
+	if err := json.Unmarshal(respBody, &rule); err != nil {
+		return nil, fmt.Errorf("failed to parse create load balancer response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// UpdateLoadBalancer updates the name, description or algorithm of a load balancer
+func (c *Client) UpdateLoadBalancer(req models.UpdateLoadBalancerRequest) error {
+	_, err := c.Post("/loadbalancer/updateLoadBalancerRule", req)
+	if err != nil {
+		return fmt.Errorf("failed to update load balancer: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLoadBalancer deletes a load balancer rule
+func (c *Client) DeleteLoadBalancer(uuid string) error {
+	path := fmt.Sprintf("/loadbalancer/deleteLoadBalancerRule?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete load balancer: %w", err)
+	}
+
+	return nil
+}
+
+// AddLoadBalancerMembers assigns instances to a load balancer
+func (c *Client) AddLoadBalancerMembers(uuid string, instanceUUIDs []string) error {
+	path := fmt.Sprintf("/loadbalancer/assignToLoadBalancerRule?uuid=%s&virtualmachineUuids=%s",
+		url.QueryEscape(uuid), url.QueryEscape(strings.Join(instanceUUIDs, ",")))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to add load balancer members: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveLoadBalancerMembers removes instances from a load balancer
+func (c *Client) RemoveLoadBalancerMembers(uuid string, instanceUUIDs []string) error {
+	path := fmt.Sprintf("/loadbalancer/removeFromLoadBalancerRule?uuid=%s&virtualmachineUuids=%s",
+		url.QueryEscape(uuid), url.QueryEscape(strings.Join(instanceUUIDs, ",")))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to remove load balancer members: %w", err)
+	}
+
+	return nil
+}
+
+// SetStickinessPolicy enables session stickiness on a load balancer
+func (c *Client) SetStickinessPolicy(req models.StickinessPolicyRequest) error {
+	_, err := c.Post("/loadbalancer/createStickinessPolicy", req)
+	if err != nil {
+		return fmt.Errorf("failed to set stickiness policy: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStickinessPolicy disables session stickiness on a load balancer
+func (c *Client) DeleteStickinessPolicy(uuid string) error {
+	path := fmt.Sprintf("/loadbalancer/deleteStickinessPolicy?loadBalancerRuleUuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to remove stickiness policy: %w", err)
+	}
+
+	return nil
+}
+
+// SetHealthCheckPolicy configures the health check of a load balancer
+func (c *Client) SetHealthCheckPolicy(req models.HealthCheckPolicyRequest) error {
+	_, err := c.Post("/loadbalancer/createHealthCheckPolicy", req)
+	if err != nil {
+		return fmt.Errorf("failed to set health check policy: %w", err)
+	}
+
+	return nil
+}
@@ -96,3 +96,244 @@ return nil, fmt.Errorf("failed to parse firewall rules response: %w", err)
 
 return response.ListFirewallRuleResponse, nil
 }
+
+// FindNetwork resolves a network by UUID or name within a region
+func (c *Client) FindNetwork(nameOrUUID, regionName string) (*models.Network, error) {
+networks, err := c.ListNetworks(regionName)
+if err != nil {
+return nil, err
+}
+
+var matches []models.Network
+for _, net := range networks {
+if net.UUID == nameOrUUID {
+return &net, nil
+}
+if net.Name == nameOrUUID {
+matches = append(matches, net)
+}
+}
+
+switch len(matches) {
+case 0:
+return nil, fmt.Errorf("network not found: %s. Run 'sannti network list' for available networks", nameOrUUID)
+case 1:
+return &matches[0], nil
+default:
+return nil, fmt.Errorf("network name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+}
+}
+
+// ListNetworkOfferings retrieves the network offerings available in a region
+func (c *Client) ListNetworkOfferings(regionName string) ([]models.NetworkOffering, error) {
+path := "/network/networkOfferingList"
+
+if regionName == "" {
+return nil, fmt.Errorf("region is required for listing network offerings")
+}
+
+zoneUUID, err := c.GetZoneUUID(regionName)
+if err != nil {
+return nil, err
+}
+path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+
+respBody, err := c.Get(path)
+if err != nil {
+return nil, err
+}
+
+var response struct {
+ListNetworkOfferingResponse []models.NetworkOffering `json:"listNetworkOfferingResponse"`
+Count                       int                      `json:"count"`
+}
+
+if err := json.Unmarshal(respBody, &response); err != nil {
+return nil, fmt.Errorf("failed to parse network offerings response: %w", err)
+}
+
+return response.ListNetworkOfferingResponse, nil
+}
+
+// FindNetworkOffering resolves a network offering by UUID or name within a region
+func (c *Client) FindNetworkOffering(nameOrUUID, regionName string) (*models.NetworkOffering, error) {
+offerings, err := c.ListNetworkOfferings(regionName)
+if err != nil {
+return nil, err
+}
+
+for _, off := range offerings {
+if off.UUID == nameOrUUID || off.Name == nameOrUUID {
+return &off, nil
+}
+}
+
+return nil, fmt.Errorf("network offering '%s' not found. Run 'sannti network offerings' for available offerings", nameOrUUID)
+}
+
+// CreateNetwork creates a new network
+func (c *Client) CreateNetwork(req models.CreateNetworkRequest) (*models.Network, error) {
+zoneUUID, err := c.GetZoneUUID(req.Region)
+if err != nil {
+return nil, err
+}
+req.ZoneUUID = zoneUUID
+
+respBody, err := c.Post("/network/createNetwork", req)
+if err != nil {
+return nil, err
+}
+
+var network models.Network
+if err := json.Unmarshal(respBody, &network); err != nil {
+return nil, fmt.Errorf("failed to parse create network response: %w", err)
+}
+
+return &network, nil
+}
+
+// UpdateNetwork updates the name or description of a network
+func (c *Client) UpdateNetwork(req models.UpdateNetworkRequest) (*models.Network, error) {
+respBody, err := c.Post("/network/updateNetwork", req)
+if err != nil {
+return nil, err
+}
+
+var network models.Network
+if err := json.Unmarshal(respBody, &network); err != nil {
+return nil, fmt.Errorf("failed to parse update network response: %w", err)
+}
+
+return &network, nil
+}
+
+// DeleteNetwork deletes a network
+func (c *Client) DeleteNetwork(uuid string) error {
+path := fmt.Sprintf("/network/deleteNetwork?uuid=%s", url.QueryEscape(uuid))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to delete network: %w", err)
+}
+
+return nil
+}
+
+// FindIPAddress resolves a public IP address by UUID or address within a region
+func (c *Client) FindIPAddress(addressOrUUID, regionName string) (*models.IPAddress, error) {
+ips, err := c.ListIPAddresses(regionName)
+if err != nil {
+return nil, err
+}
+
+for _, ip := range ips {
+if ip.UUID == addressOrUUID || ip.IpAddress == addressOrUUID {
+return &ip, nil
+}
+}
+
+return nil, fmt.Errorf("IP address not found: %s. Run 'sannti ip list' for available addresses", addressOrUUID)
+}
+
+// AcquireIPAddress allocates a new public IP address for a network
+func (c *Client) AcquireIPAddress(networkUUID, regionName string) (*models.IPAddress, error) {
+zoneUUID, err := c.GetZoneUUID(regionName)
+if err != nil {
+return nil, err
+}
+
+path := fmt.Sprintf("/ipaddress/acquireIpAddress?networkUuid=%s&zoneUuid=%s",
+url.QueryEscape(networkUUID), url.QueryEscape(zoneUUID))
+
+respBody, err := c.Get(path)
+if err != nil {
+return nil, err
+}
+
+var ip models.IPAddress
+if err := json.Unmarshal(respBody, &ip); err != nil {
+return nil, fmt.Errorf("failed to parse acquire IP address response: %w", err)
+}
+
+return &ip, nil
+}
+
+// ReleaseIPAddress releases a public IP address back to the pool
+func (c *Client) ReleaseIPAddress(uuid string) error {
+path := fmt.Sprintf("/ipaddress/releaseIpAddress?uuid=%s", url.QueryEscape(uuid))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to release IP address: %w", err)
+}
+
+return nil
+}
+
+// EnableStaticNat maps a public IP address one-to-one to an instance
+func (c *Client) EnableStaticNat(ipUUID, instanceUUID string) error {
+path := fmt.Sprintf("/ipaddress/enableStaticNat?uuid=%s&virtualmachineUuid=%s",
+url.QueryEscape(ipUUID), url.QueryEscape(instanceUUID))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to enable static NAT: %w", err)
+}
+
+return nil
+}
+
+// DisableStaticNat removes the static NAT mapping of a public IP address
+func (c *Client) DisableStaticNat(ipUUID string) error {
+path := fmt.Sprintf("/ipaddress/disableStaticNat?uuid=%s", url.QueryEscape(ipUUID))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to disable static NAT: %w", err)
+}
+
+return nil
+}
+
+// FindFirewallRule resolves a firewall rule by UUID within a region
+func (c *Client) FindFirewallRule(uuid, regionName string) (*models.FirewallRule, error) {
+rules, err := c.ListFirewallRules(regionName)
+if err != nil {
+return nil, err
+}
+
+for _, rule := range rules {
+if rule.UUID == uuid {
+return &rule, nil
+}
+}
+
+return nil, fmt.Errorf("firewall rule not found: %s", uuid)
+}
+
+// CreateFirewallRule creates a firewall rule on a public IP address
+func (c *Client) CreateFirewallRule(req models.CreateFirewallRuleRequest) (*models.FirewallRule, error) {
+respBody, err := c.Post("/firewallrule/createFirewallRule", req)
+if err != nil {
+return nil, err
+}
+
+var rule models.FirewallRule
+if err := json.Unmarshal(respBody, &rule); err != nil {
+return nil, fmt.Errorf("failed to parse create firewall rule response: %w", err)
+}
+
+return &rule, nil
+}
+
+// DeleteFirewallRule deletes a firewall rule
+func (c *Client) DeleteFirewallRule(uuid string) error {
+path := fmt.Sprintf("/firewallrule/deleteFirewallRule?uuid=%s", url.QueryEscape(uuid))
+
+_, err := c.Get(path)
+if err != nil {
+return fmt.Errorf("failed to delete firewall rule: %w", err)
+}
+
+return nil
+}
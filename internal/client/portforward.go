@@ -0,0 +1,81 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListPortForwardingRules retrieves the port forwarding rules in a region
+func (c *Client) ListPortForwardingRules(regionName string) ([]models.PortForwardingRule, error) {
+	path := "/portforwardingrule/portForwardingRuleList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListPortForwardingRuleResponse []models.PortForwardingRule `json:"listPortForwardingRuleResponse"`
+		Count                          int                         `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse port forwarding rules response: %w", err)
+	}
+
+	return response.ListPortForwardingRuleResponse, nil
+}
+
+// FindPortForwardingRule resolves a port forwarding rule by UUID within a region
+func (c *Client) FindPortForwardingRule(uuid, regionName string) (*models.PortForwardingRule, error) {
+	rules, err := c.ListPortForwardingRules(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.UUID == uuid {
+			return &rule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("port forwarding rule not found: %s", uuid)
+}
+
+// CreatePortForwardingRule creates a port forwarding rule on a public IP address
+func (c *Client) CreatePortForwardingRule(req models.CreatePortForwardingRuleRequest) (*models.PortForwardingRule, error) {
+	respBody, err := c.Post("/portforwardingrule/createPortForwardingRule", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule models.PortForwardingRule
+	if err := json.Unmarshal(respBody, &rule); err != nil {
+		return nil, fmt.Errorf("failed to parse create port forwarding rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// DeletePortForwardingRule deletes a port forwarding rule
+func (c *Client) DeletePortForwardingRule(uuid string) error {
+	path := fmt.Sprintf("/portforwardingrule/deletePortForwardingRule?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete port forwarding rule: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListSnapshots retrieves the volume snapshots in a region
+func (c *Client) ListSnapshots(regionName string) ([]models.Snapshot, error) {
+	path := "/snapshot/snapshotList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListSnapshotResponse []models.Snapshot `json:"listSnapshotResponse"`
+		Count                int               `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshots response: %w", err)
+	}
+
+	return response.ListSnapshotResponse, nil
+}
+
+// FindSnapshot resolves a volume snapshot by UUID or name within a region
+func (c *Client) FindSnapshot(nameOrUUID, regionName string) (*models.Snapshot, error) {
+	snapshots, err := c.ListSnapshots(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.Snapshot
+	for _, snap := range snapshots {
+		if snap.UUID == nameOrUUID {
+			return &snap, nil
+		}
+		if snap.Name == nameOrUUID {
+			matches = append(matches, snap)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("snapshot not found: %s. Run 'sannti snapshot list' for available snapshots", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("snapshot name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateSnapshot takes a snapshot of a volume
+func (c *Client) CreateSnapshot(req models.CreateSnapshotRequest) (*models.Snapshot, error) {
+	respBody, err := c.Post("/snapshot/createSnapshot", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var snap models.Snapshot
+	if err := json.Unmarshal(respBody, &snap); err != nil {
+		return nil, fmt.Errorf("failed to parse create snapshot response: %w", err)
+	}
+
+	return &snap, nil
+}
+
+// DeleteSnapshot deletes a volume snapshot
+func (c *Client) DeleteSnapshot(uuid string) error {
+	path := fmt.Sprintf("/snapshot/deleteSnapshot?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// RevertSnapshot rolls a volume back to the state captured in a snapshot
+func (c *Client) RevertSnapshot(uuid string) error {
+	path := fmt.Sprintf("/snapshot/revertSnapshot?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to revert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// ListSnapshotPolicies retrieves the recurring snapshot policies of a volume
+func (c *Client) ListSnapshotPolicies(volumeUUID string) ([]models.SnapshotPolicy, error) {
+	path := fmt.Sprintf("/snapshot/snapshotPolicyList?volumeUuid=%s", url.QueryEscape(volumeUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListSnapshotPolicyResponse []models.SnapshotPolicy `json:"listSnapshotPolicyResponse"`
+		Count                      int                     `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot policies response: %w", err)
+	}
+
+	return response.ListSnapshotPolicyResponse, nil
+}
+
+// CreateSnapshotPolicy sets a recurring snapshot policy on a volume
+func (c *Client) CreateSnapshotPolicy(req models.CreateSnapshotPolicyRequest) (*models.SnapshotPolicy, error) {
+	respBody, err := c.Post("/snapshot/createSnapshotPolicy", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy models.SnapshotPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse create snapshot policy response: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// DeleteSnapshotPolicy removes a recurring snapshot policy
+func (c *Client) DeleteSnapshotPolicy(uuid string) error {
+	path := fmt.Sprintf("/snapshot/deleteSnapshotPolicy?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot policy: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListSSHKeys retrieves the SSH key pairs registered in a region
+func (c *Client) ListSSHKeys(regionName string) ([]models.SSHKeyPair, error) {
+	path := "/sshkey/sshkeyList"
+
+	if regionName == "" {
+		return nil, fmt.Errorf("region is required for listing SSH keys")
+	}
+
+	zoneUUID, err := c.GetZoneUUID(regionName)
+	if err != nil {
+		return nil, err
+	}
+	path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListSSHKeyResponse []models.SSHKeyPair `json:"listSshKeyResponse"`
+		Count              int                 `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse SSH keys response: %w", err)
+	}
+
+	return response.ListSSHKeyResponse, nil
+}
+
+// GetSSHKey finds an SSH key pair by name in a region
+func (c *Client) GetSSHKey(name, regionName string) (*models.SSHKeyPair, error) {
+	keys, err := c.ListSSHKeys(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.Name == name {
+			return &key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("SSH key '%s' not found in region '%s'. Run 'sannti ssh-key list' for available keys", name, regionName)
+}
+
+// ImportSSHKey registers a public key with the platform
+func (c *Client) ImportSSHKey(req models.ImportSSHKeyRequest) (*models.SSHKeyPair, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/sshkey/createSshkey", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key models.SSHKeyPair
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		return nil, fmt.Errorf("failed to parse import SSH key response: %w", err)
+	}
+
+	return &key, nil
+}
+
+// DeleteSSHKey deletes an SSH key pair
+func (c *Client) DeleteSSHKey(uuid string) error {
+	path := fmt.Sprintf("/sshkey/deleteSshkey?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete SSH key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// tagResourceTypes maps user-facing resource types to the API resource types
+var tagResourceTypes = map[string]string{
+	"instance": "UserVm",
+	"network":  "Network",
+	"k8s":      "KubernetesCluster",
+	"ip":       "PublicIpAddress",
+}
+
+// TagResourceTypes returns the resource types that can be tagged
+func TagResourceTypes() []string {
+	types := make([]string, 0, len(tagResourceTypes))
+	for t := range tagResourceTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// resolveTagResourceType converts a user-facing resource type to its API name
+func resolveTagResourceType(resourceType string) (string, error) {
+	apiType, ok := tagResourceTypes[resourceType]
+	if !ok {
+		return "", fmt.Errorf("unsupported resource type '%s'. Supported types: %v", resourceType, TagResourceTypes())
+	}
+	return apiType, nil
+}
+
+// ListTags retrieves tags, optionally filtered by resource type, resource UUID and region
+func (c *Client) ListTags(resourceType, resourceUUID, regionName string) ([]models.Tag, error) {
+	params := url.Values{}
+
+	if resourceType != "" {
+		apiType, err := resolveTagResourceType(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("resourceType", apiType)
+	}
+
+	if resourceUUID != "" {
+		params.Set("resourceUuid", resourceUUID)
+	}
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("zoneUuid", zoneUUID)
+	}
+
+	path := "/tag/tagList"
+	if len(params) > 0 {
+		path = path + "?" + params.Encode()
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListTagResponse []models.Tag `json:"listTagResponse"`
+		Count           int          `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+
+	return response.ListTagResponse, nil
+}
+
+// CreateTags adds tags to a resource, overwriting values of existing keys
+func (c *Client) CreateTags(resourceType, resourceUUID string, tags []models.Tag) error {
+	apiType, err := resolveTagResourceType(resourceType)
+	if err != nil {
+		return err
+	}
+
+	req := models.TagsRequest{
+		ResourceType: apiType,
+		ResourceUUID: resourceUUID,
+		Tags:         tags,
+	}
+
+	if _, err := c.Post("/tag/createTags", req); err != nil {
+		return fmt.Errorf("failed to create tags: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTags removes tags from a resource. Only the keys of the given tags are used.
+func (c *Client) DeleteTags(resourceType, resourceUUID string, tags []models.Tag) error {
+	apiType, err := resolveTagResourceType(resourceType)
+	if err != nil {
+		return err
+	}
+
+	req := models.TagsRequest{
+		ResourceType: apiType,
+		ResourceUUID: resourceUUID,
+		Tags:         tags,
+	}
+
+	if _, err := c.Post("/tag/deleteTags", req); err != nil {
+		return fmt.Errorf("failed to delete tags: %w", err)
+	}
+
+	return nil
+}
+
+// TagResourceTypeName converts an API resource type back to its user-facing name
+func TagResourceTypeName(apiType string) string {
+	for name, t := range tagResourceTypes {
+		if t == apiType {
+			return name
+		}
+	}
+	return apiType
+}
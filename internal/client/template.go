@@ -0,0 +1,185 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// FindTemplate resolves a template by UUID or name within a region
+func (c *Client) FindTemplate(nameOrUUID, regionName string) (*models.Template, error) {
+	templates, err := c.ListTemplates(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.Template
+	for _, tpl := range templates {
+		if tpl.UUID == nameOrUUID {
+			return &tpl, nil
+		}
+		if tpl.Name == nameOrUUID {
+			matches = append(matches, tpl)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("image not found: %s. Run 'sannti compute images' for available images", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("image name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateTemplate creates a template from a volume or a volume snapshot
+func (c *Client) CreateTemplate(req models.CreateTemplateRequest) (*models.Template, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/template/createTemplate", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tpl models.Template
+	if err := json.Unmarshal(respBody, &tpl); err != nil {
+		return nil, fmt.Errorf("failed to parse create template response: %w", err)
+	}
+
+	return &tpl, nil
+}
+
+// RegisterTemplate registers an image the platform downloads from a URL
+func (c *Client) RegisterTemplate(req models.RegisterTemplateRequest) (*models.Template, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/template/registerTemplate", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tpl models.Template
+	if err := json.Unmarshal(respBody, &tpl); err != nil {
+		return nil, fmt.Errorf("failed to parse register template response: %w", err)
+	}
+
+	return &tpl, nil
+}
+
+// DeleteTemplate deletes an image
+func (c *Client) DeleteTemplate(uuid string) error {
+	path := fmt.Sprintf("/template/deleteTemplate?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	return nil
+}
+
+// ExtractTemplate starts exporting an image to a download URL
+func (c *Client) ExtractTemplate(uuid, regionName string) (*models.TemplateExtract, error) {
+	zoneUUID, err := c.GetZoneUUID(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/template/extractTemplate?uuid=%s&zoneUuid=%s&mode=HTTP_DOWNLOAD",
+		url.QueryEscape(uuid), url.QueryEscape(zoneUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract template: %w", err)
+	}
+
+	var extract models.TemplateExtract
+	if err := json.Unmarshal(respBody, &extract); err != nil {
+		return nil, fmt.Errorf("failed to parse extract template response: %w", err)
+	}
+
+	return &extract, nil
+}
+
+// GetTemplateExtract retrieves the progress of an image export
+func (c *Client) GetTemplateExtract(uuid string) (*models.TemplateExtract, error) {
+	path := fmt.Sprintf("/template/extractStatus?uuid=%s", url.QueryEscape(uuid))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var extract models.TemplateExtract
+	if err := json.Unmarshal(respBody, &extract); err != nil {
+		return nil, fmt.Errorf("failed to parse extract status response: %w", err)
+	}
+
+	return &extract, nil
+}
+
+// ListOSTypes retrieves the operating system types images can be registered as
+func (c *Client) ListOSTypes() ([]models.OSType, error) {
+	respBody, err := c.Get("/template/osTypeList")
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListOSTypeResponse []models.OSType `json:"listOsTypeResponse"`
+		Count              int             `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse OS types response: %w", err)
+	}
+
+	return response.ListOSTypeResponse, nil
+}
+
+// FindOSType resolves an OS type by UUID, by description (case-insensitive),
+// or by a fragment of the description that matches exactly one OS type
+func (c *Client) FindOSType(ref string) (*models.OSType, error) {
+	osTypes, err := c.ListOSTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.OSType
+	for _, ost := range osTypes {
+		if ost.UUID == ref || strings.EqualFold(ost.Description, ref) {
+			return &ost, nil
+		}
+		if strings.Contains(strings.ToLower(ost.Description), strings.ToLower(ref)) {
+			matches = append(matches, ost)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("OS type not found: %s. Run 'sannti image os-types' for available OS types", ref)
+	case 1:
+		return &matches[0], nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, m := range matches {
+			names = append(names, m.Description)
+		}
+		if len(names) > 5 {
+			names = append(names[:5], "...")
+		}
+		return nil, fmt.Errorf("OS type '%s' is ambiguous (%d matches: %s)", ref, len(matches), strings.Join(names, ", "))
+	}
+}
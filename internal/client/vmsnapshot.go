@@ -0,0 +1,104 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListVMSnapshots retrieves the instance snapshots in a region
+func (c *Client) ListVMSnapshots(regionName string) ([]models.VMSnapshot, error) {
+	path := "/vmsnapshot/vmSnapshotList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVMSnapshotResponse []models.VMSnapshot `json:"listVmSnapshotResponse"`
+		Count                  int                 `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse instance snapshots response: %w", err)
+	}
+
+	return response.ListVMSnapshotResponse, nil
+}
+
+// FindVMSnapshot resolves an instance snapshot by UUID or name within a region
+func (c *Client) FindVMSnapshot(nameOrUUID, regionName string) (*models.VMSnapshot, error) {
+	snapshots, err := c.ListVMSnapshots(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.VMSnapshot
+	for _, snap := range snapshots {
+		if snap.UUID == nameOrUUID {
+			return &snap, nil
+		}
+		if snap.Name == nameOrUUID {
+			matches = append(matches, snap)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("instance snapshot not found: %s. Run 'sannti compute snapshot list' for available snapshots", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("instance snapshot name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateVMSnapshot takes a snapshot of an instance
+func (c *Client) CreateVMSnapshot(req models.CreateVMSnapshotRequest) (*models.VMSnapshot, error) {
+	respBody, err := c.Post("/vmsnapshot/createVmSnapshot", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var snap models.VMSnapshot
+	if err := json.Unmarshal(respBody, &snap); err != nil {
+		return nil, fmt.Errorf("failed to parse create instance snapshot response: %w", err)
+	}
+
+	return &snap, nil
+}
+
+// RevertVMSnapshot rolls an instance back to a snapshot
+func (c *Client) RevertVMSnapshot(uuid string) error {
+	path := fmt.Sprintf("/vmsnapshot/revertToVmSnapshot?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to revert instance snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteVMSnapshot deletes an instance snapshot
+func (c *Client) DeleteVMSnapshot(uuid string) error {
+	path := fmt.Sprintf("/vmsnapshot/deleteVmSnapshot?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete instance snapshot: %w", err)
+	}
+
+	return nil
+}
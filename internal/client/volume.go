@@ -0,0 +1,199 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListVolumes retrieves the volumes in a region
+func (c *Client) ListVolumes(regionName string) ([]models.Volume, error) {
+	path := "/volume/volumeList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVolumeResponse []models.Volume `json:"listVolumeResponse"`
+		Count              int             `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse volumes response: %w", err)
+	}
+
+	return response.ListVolumeResponse, nil
+}
+
+// ListInstanceVolumes retrieves the volumes attached to an instance
+func (c *Client) ListInstanceVolumes(instanceUUID, regionName string) ([]models.Volume, error) {
+	volumes, err := c.ListVolumes(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var attached []models.Volume
+	for _, vol := range volumes {
+		if vol.VirtualMachineUUID == instanceUUID {
+			attached = append(attached, vol)
+		}
+	}
+
+	return attached, nil
+}
+
+// FindVolume resolves a volume by UUID or name within a region
+func (c *Client) FindVolume(nameOrUUID, regionName string) (*models.Volume, error) {
+	volumes, err := c.ListVolumes(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.Volume
+	for _, vol := range volumes {
+		if vol.UUID == nameOrUUID {
+			return &vol, nil
+		}
+		if vol.Name == nameOrUUID {
+			matches = append(matches, vol)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("volume not found: %s. Run 'sannti volume list' for available volumes", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("volume name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateVolume creates a new data volume
+func (c *Client) CreateVolume(req models.CreateVolumeRequest) (*models.Volume, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/volume/createVolume", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var vol models.Volume
+	if err := json.Unmarshal(respBody, &vol); err != nil {
+		return nil, fmt.Errorf("failed to parse create volume response: %w", err)
+	}
+
+	return &vol, nil
+}
+
+// DeleteVolume deletes a detached volume
+func (c *Client) DeleteVolume(uuid string) error {
+	path := fmt.Sprintf("/volume/deleteVolume?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume: %w", err)
+	}
+
+	return nil
+}
+
+// AttachVolume attaches a volume to an instance
+func (c *Client) AttachVolume(uuid, instanceUUID string) error {
+	path := fmt.Sprintf("/volume/attachVolume?uuid=%s&virtualmachineUuid=%s",
+		url.QueryEscape(uuid), url.QueryEscape(instanceUUID))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to attach volume: %w", err)
+	}
+
+	return nil
+}
+
+// DetachVolume detaches a volume from its instance
+func (c *Client) DetachVolume(uuid string) error {
+	path := fmt.Sprintf("/volume/detachVolume?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to detach volume: %w", err)
+	}
+
+	return nil
+}
+
+// ResizeVolume grows a volume to sizeGB gigabytes
+func (c *Client) ResizeVolume(uuid string, sizeGB int64) error {
+	path := fmt.Sprintf("/volume/resizeVolume?uuid=%s&size=%d", url.QueryEscape(uuid), sizeGB)
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to resize volume: %w", err)
+	}
+
+	return nil
+}
+
+// ListDiskOfferings retrieves the disk offerings available in a region
+func (c *Client) ListDiskOfferings(regionName string) ([]models.DiskOffering, error) {
+	path := "/volume/diskOfferingList"
+
+	if regionName == "" {
+		return nil, fmt.Errorf("region is required for listing disk offerings")
+	}
+
+	zoneUUID, err := c.GetZoneUUID(regionName)
+	if err != nil {
+		return nil, err
+	}
+	path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListDiskOfferingResponse []models.DiskOffering `json:"listDiskOfferingResponse"`
+		Count                    int                   `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse disk offerings response: %w", err)
+	}
+
+	return response.ListDiskOfferingResponse, nil
+}
+
+// FindDiskOffering resolves a disk offering by UUID or name within a region
+func (c *Client) FindDiskOffering(nameOrUUID, regionName string) (*models.DiskOffering, error) {
+	offerings, err := c.ListDiskOfferings(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, off := range offerings {
+		if off.UUID == nameOrUUID || off.Name == nameOrUUID {
+			return &off, nil
+		}
+	}
+
+	return nil, fmt.Errorf("disk offering '%s' not found. Run 'sannti volume offerings' for available offerings", nameOrUUID)
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListVPCs retrieves the VPCs in a region
+func (c *Client) ListVPCs(regionName string) ([]models.VPC, error) {
+	path := "/vpc/vpcList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVpcResponse []models.VPC `json:"listVpcResponse"`
+		Count           int          `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse VPCs response: %w", err)
+	}
+
+	return response.ListVpcResponse, nil
+}
+
+// FindVPC resolves a VPC by UUID or name within a region
+func (c *Client) FindVPC(nameOrUUID, regionName string) (*models.VPC, error) {
+	vpcs, err := c.ListVPCs(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.VPC
+	for _, vpc := range vpcs {
+		if vpc.UUID == nameOrUUID {
+			return &vpc, nil
+		}
+		if vpc.Name == nameOrUUID {
+			matches = append(matches, vpc)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("VPC not found: %s. Run 'sannti vpc list' for available VPCs", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("VPC name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateVPC creates a new VPC
+func (c *Client) CreateVPC(req models.CreateVPCRequest) (*models.VPC, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/vpc/createVpc", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var vpc models.VPC
+	if err := json.Unmarshal(respBody, &vpc); err != nil {
+		return nil, fmt.Errorf("failed to parse create VPC response: %w", err)
+	}
+
+	return &vpc, nil
+}
+
+// DeleteVPC deletes a VPC
+func (c *Client) DeleteVPC(uuid string) error {
+	path := fmt.Sprintf("/vpc/deleteVpc?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete VPC: %w", err)
+	}
+
+	return nil
+}
+
+// ListNetworkACLs retrieves the ACLs available to a VPC, including the
+// platform default ACLs
+func (c *Client) ListNetworkACLs(vpcUUID string) ([]models.NetworkACL, error) {
+	path := fmt.Sprintf("/networkacl/networkAclList?vpcUuid=%s", url.QueryEscape(vpcUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListNetworkACLResponse []models.NetworkACL `json:"listNetworkAclResponse"`
+		Count                  int                 `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse network ACLs response: %w", err)
+	}
+
+	return response.ListNetworkACLResponse, nil
+}
+
+// FindNetworkACL resolves a network ACL by UUID or name within a VPC. The
+// platform default ACLs are included, so a VPC ACL sharing a default's name
+// is ambiguous.
+func (c *Client) FindNetworkACL(nameOrUUID, vpcUUID string) (*models.NetworkACL, error) {
+	acls, err := c.ListNetworkACLs(vpcUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.NetworkACL
+	for _, acl := range acls {
+		if acl.UUID == nameOrUUID {
+			return &acl, nil
+		}
+		if acl.Name == nameOrUUID {
+			matches = append(matches, acl)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("network ACL not found: %s. Run 'sannti network acl list --vpc <vpc>' for available ACLs", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("network ACL name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateNetworkACL creates an empty ACL in a VPC
+func (c *Client) CreateNetworkACL(req models.CreateNetworkACLRequest) (*models.NetworkACL, error) {
+	respBody, err := c.Post("/networkacl/createNetworkAcl", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var acl models.NetworkACL
+	if err := json.Unmarshal(respBody, &acl); err != nil {
+		return nil, fmt.Errorf("failed to parse create network ACL response: %w", err)
+	}
+
+	return &acl, nil
+}
+
+// DeleteNetworkACL deletes a network ACL
+func (c *Client) DeleteNetworkACL(uuid string) error {
+	path := fmt.Sprintf("/networkacl/deleteNetworkAcl?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete network ACL: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceNetworkACL applies an ACL to a VPC tier
+func (c *Client) ReplaceNetworkACL(networkUUID, aclUUID string) error {
+	path := fmt.Sprintf("/networkacl/replaceNetworkAcl?networkUuid=%s&aclUuid=%s",
+		url.QueryEscape(networkUUID), url.QueryEscape(aclUUID))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to apply network ACL: %w", err)
+	}
+
+	return nil
+}
+
+// ListNetworkACLRules retrieves the rules of a network ACL
+func (c *Client) ListNetworkACLRules(aclUUID string) ([]models.NetworkACLRule, error) {
+	path := fmt.Sprintf("/networkacl/networkAclRuleList?aclUuid=%s", url.QueryEscape(aclUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListNetworkACLRuleResponse []models.NetworkACLRule `json:"listNetworkAclRuleResponse"`
+		Count                      int                     `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse network ACL rules response: %w", err)
+	}
+
+	return response.ListNetworkACLRuleResponse, nil
+}
+
+// CreateNetworkACLRule adds a rule to a network ACL
+func (c *Client) CreateNetworkACLRule(req models.CreateNetworkACLRuleRequest) (*models.NetworkACLRule, error) {
+	respBody, err := c.Post("/networkacl/createNetworkAclRule", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule models.NetworkACLRule
+	if err := json.Unmarshal(respBody, &rule); err != nil {
+		return nil, fmt.Errorf("failed to parse create network ACL rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// DeleteNetworkACLRule deletes a network ACL rule
+func (c *Client) DeleteNetworkACLRule(uuid string) error {
+	path := fmt.Sprintf("/networkacl/deleteNetworkAclRule?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete network ACL rule: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sannticloud/sannti-cli/internal/models"
+)
+
+// ListVPNGateways retrieves the VPN gateways in a region
+func (c *Client) ListVPNGateways(regionName string) ([]models.VPNGateway, error) {
+	path := "/vpn/vpnGatewayList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVPNGatewayResponse []models.VPNGateway `json:"listVpnGatewayResponse"`
+		Count                  int                 `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse VPN gateways response: %w", err)
+	}
+
+	return response.ListVPNGatewayResponse, nil
+}
+
+// FindVPNGateway resolves a VPN gateway by its UUID, public IP or VPC name or UUID
+func (c *Client) FindVPNGateway(ref, regionName string) (*models.VPNGateway, error) {
+	gateways, err := c.ListVPNGateways(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.VPNGateway
+	for _, gw := range gateways {
+		if gw.UUID == ref {
+			return &gw, nil
+		}
+		if gw.PublicIP == ref || gw.VpcUUID == ref || gw.VpcName == ref {
+			matches = append(matches, gw)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("VPN gateway not found: %s. Run 'sannti vpn gateway list' for available gateways", ref)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("VPN gateway reference '%s' is ambiguous (%d matches), use the UUID instead", ref, len(matches))
+	}
+}
+
+// CreateVPNGateway creates the VPN gateway of a VPC
+func (c *Client) CreateVPNGateway(vpcUUID string) (*models.VPNGateway, error) {
+	path := fmt.Sprintf("/vpn/createVpnGateway?vpcUuid=%s", url.QueryEscape(vpcUUID))
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var gw models.VPNGateway
+	if err := json.Unmarshal(respBody, &gw); err != nil {
+		return nil, fmt.Errorf("failed to parse create VPN gateway response: %w", err)
+	}
+
+	return &gw, nil
+}
+
+// DeleteVPNGateway deletes a VPN gateway
+func (c *Client) DeleteVPNGateway(uuid string) error {
+	path := fmt.Sprintf("/vpn/deleteVpnGateway?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete VPN gateway: %w", err)
+	}
+
+	return nil
+}
+
+// ListVPNCustomerGateways retrieves the customer gateways in a region
+func (c *Client) ListVPNCustomerGateways(regionName string) ([]models.VPNCustomerGateway, error) {
+	path := "/vpn/vpnCustomerGatewayList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVPNCustomerGatewayResponse []models.VPNCustomerGateway `json:"listVpnCustomerGatewayResponse"`
+		Count                          int                         `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse VPN customer gateways response: %w", err)
+	}
+
+	return response.ListVPNCustomerGatewayResponse, nil
+}
+
+// FindVPNCustomerGateway resolves a customer gateway by UUID or name within a region
+func (c *Client) FindVPNCustomerGateway(nameOrUUID, regionName string) (*models.VPNCustomerGateway, error) {
+	gateways, err := c.ListVPNCustomerGateways(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.VPNCustomerGateway
+	for _, gw := range gateways {
+		if gw.UUID == nameOrUUID {
+			return &gw, nil
+		}
+		if gw.Name == nameOrUUID {
+			matches = append(matches, gw)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("customer gateway not found: %s. Run 'sannti vpn customer-gateway list' for available gateways", nameOrUUID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("customer gateway name '%s' is ambiguous (%d matches), use the UUID instead", nameOrUUID, len(matches))
+	}
+}
+
+// CreateVPNCustomerGateway registers a customer gateway
+func (c *Client) CreateVPNCustomerGateway(req models.CreateVPNCustomerGatewayRequest) (*models.VPNCustomerGateway, error) {
+	zoneUUID, err := c.GetZoneUUID(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	req.ZoneUUID = zoneUUID
+
+	respBody, err := c.Post("/vpn/createVpnCustomerGateway", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var gw models.VPNCustomerGateway
+	if err := json.Unmarshal(respBody, &gw); err != nil {
+		return nil, fmt.Errorf("failed to parse create customer gateway response: %w", err)
+	}
+
+	return &gw, nil
+}
+
+// DeleteVPNCustomerGateway deletes a customer gateway
+func (c *Client) DeleteVPNCustomerGateway(uuid string) error {
+	path := fmt.Sprintf("/vpn/deleteVpnCustomerGateway?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete customer gateway: %w", err)
+	}
+
+	return nil
+}
+
+// ListVPNConnections retrieves the site-to-site VPN connections in a region
+func (c *Client) ListVPNConnections(regionName string) ([]models.VPNConnection, error) {
+	path := "/vpn/vpnConnectionList"
+
+	if regionName != "" {
+		zoneUUID, err := c.GetZoneUUID(regionName)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("%s?zoneUuid=%s", path, url.QueryEscape(zoneUUID))
+	}
+
+	respBody, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		ListVPNConnectionResponse []models.VPNConnection `json:"listVpnConnectionResponse"`
+		Count                     int                    `json:"count"`
+	}
+
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse VPN connections response: %w", err)
+	}
+
+	return response.ListVPNConnectionResponse, nil
+}
+
+// FindVPNConnection resolves a VPN connection by UUID within a region
+func (c *Client) FindVPNConnection(uuid, regionName string) (*models.VPNConnection, error) {
+	conns, err := c.ListVPNConnections(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conn := range conns {
+		if conn.UUID == uuid {
+			return &conn, nil
+		}
+	}
+
+	return nil, fmt.Errorf("VPN connection not found: %s", uuid)
+}
+
+// CreateVPNConnection connects a VPN gateway to a customer gateway
+func (c *Client) CreateVPNConnection(req models.CreateVPNConnectionRequest) (*models.VPNConnection, error) {
+	respBody, err := c.Post("/vpn/createVpnConnection", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var conn models.VPNConnection
+	if err := json.Unmarshal(respBody, &conn); err != nil {
+		return nil, fmt.Errorf("failed to parse create VPN connection response: %w", err)
+	}
+
+	return &conn, nil
+}
+
+// ResetVPNConnection tears down and re-establishes a VPN tunnel
+func (c *Client) ResetVPNConnection(uuid string) error {
+	path := fmt.Sprintf("/vpn/resetVpnConnection?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to reset VPN connection: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteVPNConnection deletes a VPN connection
+func (c *Client) DeleteVPNConnection(uuid string) error {
+	path := fmt.Sprintf("/vpn/deleteVpnConnection?uuid=%s", url.QueryEscape(uuid))
+
+	_, err := c.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete VPN connection: %w", err)
+	}
+
+	return nil
+}
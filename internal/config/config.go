@@ -19,6 +19,10 @@ type Config struct {
 	AccessKey     string `mapstructure:"access_key"`
 	SecretKey     string `mapstructure:"secret_key"`
 	DefaultRegion string `mapstructure:"default_region"`
+
+	// Optional defaults for 'sannti compute ssh'
+	SSHIdentityFile string `mapstructure:"ssh_identity_file"`
+	SSHJumpHost     string `mapstructure:"ssh_jump_host"`
 }
 
 // GetConfigPath returns the path to the config file
@@ -67,6 +71,9 @@ func LoadConfig() (*Config, error) {
 		AccessKey:     viper.GetString("access_key"),
 		SecretKey:     viper.GetString("secret_key"),
 		DefaultRegion: viper.GetString("default_region"),
+
+		SSHIdentityFile: viper.GetString("ssh_identity_file"),
+		SSHJumpHost:     viper.GetString("ssh_jump_host"),
 	}
 
 	// Check for missing required fields
@@ -0,0 +1,112 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Health summarizes the state of a cluster's API server and nodes
+type Health struct {
+	APIServerReady bool     `json:"apiServerReady" yaml:"apiServerReady"`
+	ReadyzStatus   string   `json:"readyzStatus" yaml:"readyzStatus"`
+	Nodes          int      `json:"nodes" yaml:"nodes"`
+	ReadyNodes     int      `json:"readyNodes" yaml:"readyNodes"`
+	NotReadyNodes  []string `json:"notReadyNodes,omitempty" yaml:"notReadyNodes,omitempty"`
+}
+
+// ErrUnauthorized is returned when the API server rejects the credentials.
+// Unlike a failing /readyz it will not resolve by waiting.
+var ErrUnauthorized = errors.New("API server rejected the credentials")
+
+// nodeList is the subset of the Kubernetes NodeList needed for readiness
+type nodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// CheckHealth probes the API server's /readyz endpoint and counts Ready
+// nodes. server is the API server base URL and httpClient must carry the
+// cluster credentials. Node counts are only filled in once /readyz passes.
+// A 401 or 403 from /readyz returns ErrUnauthorized.
+func CheckHealth(server string, httpClient *http.Client) (*Health, error) {
+	health := &Health{}
+
+	status, body, err := get(httpClient, server+"/readyz")
+	if err != nil {
+		return health, fmt.Errorf("API server unreachable: %w", err)
+	}
+
+	health.ReadyzStatus = strings.TrimSpace(body)
+	if status == http.StatusUnauthorized || status == http.StatusForbidden {
+		return health, fmt.Errorf("%w (HTTP %d)", ErrUnauthorized, status)
+	}
+	if status != http.StatusOK {
+		if health.ReadyzStatus == "" {
+			health.ReadyzStatus = fmt.Sprintf("HTTP %d", status)
+		}
+		return health, nil
+	}
+	health.APIServerReady = true
+
+	status, body, err = get(httpClient, server+"/api/v1/nodes")
+	if err != nil {
+		return health, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	if status != http.StatusOK {
+		return health, fmt.Errorf("failed to list nodes (status %d): %s", status, body)
+	}
+
+	var nodes nodeList
+	if err := json.Unmarshal([]byte(body), &nodes); err != nil {
+		return health, fmt.Errorf("failed to parse nodes response: %w", err)
+	}
+
+	health.Nodes = len(nodes.Items)
+	for _, node := range nodes.Items {
+		ready := false
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == "Ready" && cond.Status == "True" {
+				ready = true
+				break
+			}
+		}
+		if ready {
+			health.ReadyNodes++
+		} else {
+			health.NotReadyNodes = append(health.NotReadyNodes, node.Metadata.Name)
+		}
+	}
+	sort.Strings(health.NotReadyNodes)
+
+	return health, nil
+}
+
+// get performs a GET request and returns the status code and body
+func get(httpClient *http.Client, url string) (int, string, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return resp.StatusCode, string(body), nil
+}
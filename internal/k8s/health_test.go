@@ -0,0 +1,179 @@
+package k8s
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sannticloud/sannti-cli/internal/kubeconfig"
+)
+
+const testToken = "good-token"
+
+const mixedNodes = `{"items": [
+	{"metadata": {"name": "node-b"}, "status": {"conditions": [{"type": "Ready", "status": "False"}]}},
+	{"metadata": {"name": "node-a"}, "status": {"conditions": [{"type": "MemoryPressure", "status": "False"}, {"type": "Ready", "status": "True"}]}},
+	{"metadata": {"name": "node-c"}, "status": {"conditions": [{"type": "Ready", "status": "Unknown"}]}},
+	{"metadata": {"name": "node-d"}, "status": {"conditions": [{"type": "Ready", "status": "True"}]}}
+]}`
+
+// fakeAPIServer serves /readyz and /api/v1/nodes, requiring testToken
+func fakeAPIServer(t *testing.T, readyzStatus int, readyzBody, nodes string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"kind":"Status","status":"Failure","message":"Unauthorized","code":401}`)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/readyz":
+			w.WriteHeader(readyzStatus)
+			fmt.Fprint(w, readyzBody)
+		case "/api/v1/nodes":
+			fmt.Fprint(w, nodes)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// restClient builds an HTTP client for srv through a kubeconfig, the same way
+// the k8s health command does
+func restClient(t *testing.T, srv *httptest.Server, token string) (string, *http.Client) {
+	t.Helper()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	data := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test
+  cluster:
+    server: %s/
+    certificate-authority-data: %s
+users:
+- name: test
+  user:
+    token: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+`, srv.URL, base64.StdEncoding.EncodeToString(ca), token)
+
+	cfg, err := kubeconfig.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	server, httpClient, err := cfg.RESTClient(5 * time.Second)
+	if err != nil {
+		t.Fatalf("RESTClient: %v", err)
+	}
+	if server != srv.URL {
+		t.Fatalf("server = %q, want %q", server, srv.URL)
+	}
+	return server, httpClient
+}
+
+func TestCheckHealthReady(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusOK, "ok", mixedNodes)
+	server, httpClient := restClient(t, srv, testToken)
+
+	health, err := CheckHealth(server, httpClient)
+	if err != nil {
+		t.Fatalf("CheckHealth: %v", err)
+	}
+
+	want := &Health{
+		APIServerReady: true,
+		ReadyzStatus:   "ok",
+		Nodes:          4,
+		ReadyNodes:     2,
+		NotReadyNodes:  []string{"node-b", "node-c"},
+	}
+	if !reflect.DeepEqual(health, want) {
+		t.Errorf("health = %+v, want %+v", health, want)
+	}
+}
+
+func TestCheckHealthReadyzFailing(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusInternalServerError, "[-]etcd failed: reason withheld\nreadyz check failed\n", mixedNodes)
+	server, httpClient := restClient(t, srv, testToken)
+
+	health, err := CheckHealth(server, httpClient)
+	if err != nil {
+		t.Fatalf("CheckHealth: %v", err)
+	}
+
+	if health.APIServerReady {
+		t.Error("APIServerReady = true, want false")
+	}
+	if !strings.Contains(health.ReadyzStatus, "etcd failed") {
+		t.Errorf("ReadyzStatus = %q, want the /readyz body", health.ReadyzStatus)
+	}
+	if health.Nodes != 0 || health.ReadyNodes != 0 {
+		t.Errorf("nodes = %d/%d, want no node counts while /readyz fails", health.ReadyNodes, health.Nodes)
+	}
+}
+
+func TestCheckHealthEmptyReadyzBody(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusServiceUnavailable, "", mixedNodes)
+	server, httpClient := restClient(t, srv, testToken)
+
+	health, err := CheckHealth(server, httpClient)
+	if err != nil {
+		t.Fatalf("CheckHealth: %v", err)
+	}
+	if health.ReadyzStatus != "HTTP 503" {
+		t.Errorf("ReadyzStatus = %q, want %q", health.ReadyzStatus, "HTTP 503")
+	}
+}
+
+func TestCheckHealthUnauthorized(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusOK, "ok", mixedNodes)
+	server, httpClient := restClient(t, srv, "bad-token")
+
+	health, err := CheckHealth(server, httpClient)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("CheckHealth with bad credentials: err = %v, want ErrUnauthorized", err)
+	}
+	if !strings.Contains(err.Error(), "401") {
+		t.Errorf("err = %q, want it to mention the 401 status", err)
+	}
+	if health.APIServerReady {
+		t.Error("APIServerReady = true with bad credentials, want false")
+	}
+}
+
+func TestCheckHealthForbidden(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusForbidden, `{"kind":"Status","status":"Failure","reason":"Forbidden","code":403}`, mixedNodes)
+	server, httpClient := restClient(t, srv, testToken)
+
+	if _, err := CheckHealth(server, httpClient); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("CheckHealth with a 403: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestCheckHealthUnreachable(t *testing.T) {
+	srv := fakeAPIServer(t, http.StatusOK, "ok", mixedNodes)
+	server, httpClient := restClient(t, srv, testToken)
+	srv.Close()
+
+	if _, err := CheckHealth(server, httpClient); err == nil {
+		t.Error("CheckHealth on a closed server: want an error")
+	}
+}
@@ -0,0 +1,255 @@
+package kubeconfig
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a kubeconfig file. Cluster and user bodies are kept as generic
+// maps so fields this package does not know about survive a merge.
+type Config struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []NamedCluster         `yaml:"clusters"`
+	Users          []NamedUser            `yaml:"users"`
+	Contexts       []NamedContext         `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Preferences    map[string]interface{} `yaml:"preferences,omitempty"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+// NamedCluster is a cluster entry
+type NamedCluster struct {
+	Name    string                 `yaml:"name"`
+	Cluster map[string]interface{} `yaml:"cluster"`
+}
+
+// NamedUser is a user (auth info) entry
+type NamedUser struct {
+	Name string                 `yaml:"name"`
+	User map[string]interface{} `yaml:"user"`
+}
+
+// NamedContext is a context entry
+type NamedContext struct {
+	Name    string  `yaml:"name"`
+	Context Context `yaml:"context"`
+}
+
+// Context binds a cluster to a user
+type Context struct {
+	Cluster   string                 `yaml:"cluster"`
+	User      string                 `yaml:"user"`
+	Namespace string                 `yaml:"namespace,omitempty"`
+	Extra     map[string]interface{} `yaml:",inline"`
+}
+
+// DefaultPath returns the kubeconfig file to write to: the first entry of
+// $KUBECONFIG if set, otherwise ~/.kube/config
+func DefaultPath() (string, error) {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		for _, p := range filepath.SplitList(env) {
+			if p != "" {
+				return p, nil
+			}
+		}
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// Parse decodes a kubeconfig document
+func Parse(data []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Load reads a kubeconfig file. A missing file yields an empty config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{APIVersion: "v1", Kind: "Config"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Save writes the config atomically with owner-only permissions
+func (c *Config) Save(path string) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	encoder.Close()
+	data := buf.Bytes()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create kubeconfig directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".kubeconfig-*")
+	if err != nil {
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set kubeconfig permissions: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+
+	return nil
+}
+
+// CurrentEntries returns the context, cluster and user selected by the
+// config's current-context, falling back to its first context
+func (c *Config) CurrentEntries() (*NamedContext, *NamedCluster, *NamedUser, error) {
+	if len(c.Contexts) == 0 {
+		return nil, nil, nil, fmt.Errorf("kubeconfig has no contexts")
+	}
+
+	ctx := &c.Contexts[0]
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == c.CurrentContext {
+			ctx = &c.Contexts[i]
+			break
+		}
+	}
+
+	var cluster *NamedCluster
+	for i := range c.Clusters {
+		if c.Clusters[i].Name == ctx.Context.Cluster {
+			cluster = &c.Clusters[i]
+			break
+		}
+	}
+	if cluster == nil {
+		return nil, nil, nil, fmt.Errorf("kubeconfig context '%s' references unknown cluster '%s'", ctx.Name, ctx.Context.Cluster)
+	}
+
+	var user *NamedUser
+	for i := range c.Users {
+		if c.Users[i].Name == ctx.Context.User {
+			user = &c.Users[i]
+			break
+		}
+	}
+	if user == nil {
+		return nil, nil, nil, fmt.Errorf("kubeconfig context '%s' references unknown user '%s'", ctx.Name, ctx.Context.User)
+	}
+
+	return ctx, cluster, user, nil
+}
+
+// Merge copies the current context of src into dst, renaming its context,
+// cluster and user to name. Existing entries with that name that differ
+// from src are only replaced when overwrite is set. The merged context
+// becomes dst's current context when switchContext is set or dst has none.
+func Merge(dst, src *Config, name string, overwrite, switchContext bool) error {
+	srcCtx, srcCluster, srcUser, err := src.CurrentEntries()
+	if err != nil {
+		return err
+	}
+
+	cluster := NamedCluster{Name: name, Cluster: srcCluster.Cluster}
+	user := NamedUser{Name: name, User: srcUser.User}
+	ctx := NamedContext{Name: name, Context: Context{
+		Cluster:   name,
+		User:      name,
+		Namespace: srcCtx.Context.Namespace,
+		Extra:     srcCtx.Context.Extra,
+	}}
+
+	var conflicts []string
+	clusterIdx, userIdx, ctxIdx := -1, -1, -1
+	for i, e := range dst.Clusters {
+		if e.Name == name {
+			clusterIdx = i
+			if !reflect.DeepEqual(e, cluster) {
+				conflicts = append(conflicts, "cluster")
+			}
+		}
+	}
+	for i, e := range dst.Users {
+		if e.Name == name {
+			userIdx = i
+			if !reflect.DeepEqual(e, user) {
+				conflicts = append(conflicts, "user")
+			}
+		}
+	}
+	for i, e := range dst.Contexts {
+		if e.Name == name {
+			ctxIdx = i
+			if !reflect.DeepEqual(e, ctx) {
+				conflicts = append(conflicts, "context")
+			}
+		}
+	}
+
+	if len(conflicts) > 0 && !overwrite {
+		return fmt.Errorf("kubeconfig already has a different %s named '%s'", strings.Join(conflicts, ", "), name)
+	}
+
+	if clusterIdx >= 0 {
+		dst.Clusters[clusterIdx] = cluster
+	} else {
+		dst.Clusters = append(dst.Clusters, cluster)
+	}
+	if userIdx >= 0 {
+		dst.Users[userIdx] = user
+	} else {
+		dst.Users = append(dst.Users, user)
+	}
+	if ctxIdx >= 0 {
+		dst.Contexts[ctxIdx] = ctx
+	} else {
+		dst.Contexts = append(dst.Contexts, ctx)
+	}
+
+	if dst.APIVersion == "" {
+		dst.APIVersion = "v1"
+	}
+	if dst.Kind == "" {
+		dst.Kind = "Config"
+	}
+	if switchContext || dst.CurrentContext == "" {
+		dst.CurrentContext = name
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package kubeconfig
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// existingConfig is a user's kubeconfig with an unrelated cluster and extra
+// fields this package does not model
+const existingConfig = `apiVersion: v1
+kind: Config
+current-context: work
+preferences:
+  colors: true
+clusters:
+- name: work
+  cluster:
+    server: https://work.example.com
+    insecure-skip-tls-verify: true
+users:
+- name: work
+  user:
+    exec:
+      command: work-login
+contexts:
+- name: work
+  context:
+    cluster: work
+    user: work
+    namespace: team-a
+extensions:
+- name: vendor
+`
+
+// clusterConfig is a kubeconfig as returned by the API for one cluster
+const clusterConfig = `apiVersion: v1
+kind: Config
+current-context: admin@prod
+clusters:
+- name: prod
+  cluster:
+    server: https://203.0.113.10:6443
+    certificate-authority-data: Q0E=
+users:
+- name: admin
+  user:
+    token: secret
+contexts:
+- name: admin@prod
+  context:
+    cluster: prod
+    user: admin
+`
+
+const mergedName = "sannti-zone1-prod"
+
+func parse(t *testing.T, data string) *Config {
+	t.Helper()
+	cfg, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return cfg
+}
+
+// entryNames lists the cluster, user and context names of a config
+func entryNames(cfg *Config) (clusters, users, contexts []string) {
+	for _, e := range cfg.Clusters {
+		clusters = append(clusters, e.Name)
+	}
+	for _, e := range cfg.Users {
+		users = append(users, e.Name)
+	}
+	for _, e := range cfg.Contexts {
+		contexts = append(contexts, e.Name)
+	}
+	return clusters, users, contexts
+}
+
+func TestMergeAddsRenamedEntries(t *testing.T) {
+	dst := parse(t, existingConfig)
+	src := parse(t, clusterConfig)
+
+	if err := Merge(dst, src, mergedName, false, false); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+
+	clusters, users, contexts := entryNames(dst)
+	want := []string{"work", mergedName}
+	if !reflect.DeepEqual(clusters, want) || !reflect.DeepEqual(users, want) || !reflect.DeepEqual(contexts, want) {
+		t.Fatalf("entries = %v / %v / %v, want %v for each", clusters, users, contexts, want)
+	}
+
+	ctx := dst.Contexts[1].Context
+	if ctx.Cluster != mergedName || ctx.User != mergedName {
+		t.Errorf("merged context = %+v, want cluster and user %q", ctx, mergedName)
+	}
+	if dst.Clusters[1].Cluster["server"] != "https://203.0.113.10:6443" {
+		t.Errorf("merged cluster = %v, want the source cluster body", dst.Clusters[1].Cluster)
+	}
+	if dst.Users[1].User["token"] != "secret" {
+		t.Errorf("merged user = %v, want the source user body", dst.Users[1].User)
+	}
+
+	if dst.CurrentContext != "work" {
+		t.Errorf("CurrentContext = %q, want it unchanged without switchContext", dst.CurrentContext)
+	}
+}
+
+func TestMergeSwitchContext(t *testing.T) {
+	tests := []struct {
+		name          string
+		dst           string
+		switchContext bool
+		want          string
+	}{
+		{"keep current context", existingConfig, false, "work"},
+		{"switch context", existingConfig, true, mergedName},
+		{"empty kubeconfig", "", false, mergedName},
+	}
+
+	for _, tt := range tests {
+		dst := parse(t, tt.dst)
+		if err := Merge(dst, parse(t, clusterConfig), mergedName, false, tt.switchContext); err != nil {
+			t.Fatalf("%s: Merge: %v", tt.name, err)
+		}
+		if dst.CurrentContext != tt.want {
+			t.Errorf("%s: CurrentContext = %q, want %q", tt.name, dst.CurrentContext, tt.want)
+		}
+	}
+}
+
+func TestMergeNameCollision(t *testing.T) {
+	// The same cluster merged twice is not a conflict
+	dst := parse(t, existingConfig)
+	if err := Merge(dst, parse(t, clusterConfig), mergedName, false, false); err != nil {
+		t.Fatalf("first Merge: %v", err)
+	}
+	if err := Merge(dst, parse(t, clusterConfig), mergedName, false, false); err != nil {
+		t.Fatalf("repeated Merge of the same cluster: %v", err)
+	}
+	if _, _, contexts := entryNames(dst); len(contexts) != 2 {
+		t.Fatalf("contexts = %v, want no duplicate entries", contexts)
+	}
+
+	// A rotated credential under the same name is refused without overwrite
+	rotated := parse(t, strings.Replace(clusterConfig, "token: secret", "token: rotated", 1))
+	err := Merge(dst, rotated, mergedName, false, true)
+	if err == nil || !strings.Contains(err.Error(), "user") {
+		t.Fatalf("Merge of a different user without overwrite: err = %v, want a user conflict", err)
+	}
+	if dst.Users[1].User["token"] != "secret" {
+		t.Errorf("user token = %v, want it left unchanged after a refused merge", dst.Users[1].User["token"])
+	}
+	if dst.CurrentContext != "work" {
+		t.Errorf("CurrentContext = %q, want it left unchanged after a refused merge", dst.CurrentContext)
+	}
+
+	// With overwrite the entry is replaced in place
+	if err := Merge(dst, rotated, mergedName, true, false); err != nil {
+		t.Fatalf("Merge with overwrite: %v", err)
+	}
+	clusters, users, contexts := entryNames(dst)
+	want := []string{"work", mergedName}
+	if !reflect.DeepEqual(clusters, want) || !reflect.DeepEqual(users, want) || !reflect.DeepEqual(contexts, want) {
+		t.Fatalf("entries = %v / %v / %v, want %v for each", clusters, users, contexts, want)
+	}
+	if dst.Users[1].User["token"] != "rotated" {
+		t.Errorf("user token = %v, want the overwritten credential", dst.Users[1].User["token"])
+	}
+}
+
+func TestMergeConflictingEntries(t *testing.T) {
+	tests := []struct {
+		name  string
+		dst   string
+		wants string
+	}{
+		{"cluster", "clusters:\n- name: " + mergedName + "\n  cluster:\n    server: https://other.example.com\n", "cluster"},
+		{"context", "contexts:\n- name: " + mergedName + "\n  context:\n    cluster: work\n    user: work\n", "context"},
+	}
+
+	for _, tt := range tests {
+		dst := parse(t, tt.dst)
+		err := Merge(dst, parse(t, clusterConfig), mergedName, false, false)
+		if err == nil || !strings.Contains(err.Error(), tt.wants) {
+			t.Errorf("%s: Merge without overwrite: err = %v, want a %s conflict", tt.name, err, tt.wants)
+		}
+
+		dst = parse(t, tt.dst)
+		if err := Merge(dst, parse(t, clusterConfig), mergedName, true, false); err != nil {
+			t.Errorf("%s: Merge with overwrite: %v", tt.name, err)
+		}
+	}
+}
+
+func TestMergePreservesUnrelatedEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(existingConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := Merge(dst, parse(t, clusterConfig), mergedName, false, true); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if err := dst.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	saved, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load after Save: %v", err)
+	}
+
+	original := parse(t, existingConfig)
+	if !reflect.DeepEqual(saved.Clusters[0], original.Clusters[0]) {
+		t.Errorf("work cluster = %+v, want %+v", saved.Clusters[0], original.Clusters[0])
+	}
+	if !reflect.DeepEqual(saved.Users[0], original.Users[0]) {
+		t.Errorf("work user = %+v, want %+v", saved.Users[0], original.Users[0])
+	}
+	if !reflect.DeepEqual(saved.Contexts[0], original.Contexts[0]) {
+		t.Errorf("work context = %+v, want %+v", saved.Contexts[0], original.Contexts[0])
+	}
+	if !reflect.DeepEqual(saved.Preferences, original.Preferences) {
+		t.Errorf("preferences = %v, want %v", saved.Preferences, original.Preferences)
+	}
+	if _, ok := saved.Extra["extensions"]; !ok {
+		t.Errorf("extensions were dropped: %v", saved.Extra)
+	}
+	if saved.CurrentContext != mergedName {
+		t.Errorf("CurrentContext = %q, want %q", saved.CurrentContext, mergedName)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("kubeconfig permissions = %o, want 600", perm)
+	}
+}
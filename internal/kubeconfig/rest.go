@@ -0,0 +1,140 @@
+package kubeconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// RESTClient returns the API server URL of the current context and an HTTP
+// client that authenticates with the context's credentials. Certificate,
+// bearer token and basic auth credentials are supported; exec and auth
+// provider plugins are not.
+func (c *Config) RESTClient(timeout time.Duration) (string, *http.Client, error) {
+	_, cluster, user, err := c.CurrentEntries()
+	if err != nil {
+		return "", nil, err
+	}
+
+	server := stringField(cluster.Cluster, "server")
+	if server == "" {
+		return "", nil, fmt.Errorf("kubeconfig cluster '%s' has no server", cluster.Name)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: boolField(cluster.Cluster, "insecure-skip-tls-verify"),
+	}
+
+	caData, err := dataField(cluster.Cluster, "certificate-authority")
+	if err != nil {
+		return "", nil, err
+	}
+	if caData != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return "", nil, fmt.Errorf("kubeconfig cluster '%s' has an invalid certificate authority", cluster.Name)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certData, err := dataField(user.User, "client-certificate")
+	if err != nil {
+		return "", nil, err
+	}
+	keyData, err := dataField(user.User, "client-key")
+	if err != nil {
+		return "", nil, err
+	}
+	if certData != nil && keyData != nil {
+		cert, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			return "", nil, fmt.Errorf("kubeconfig user '%s' has an invalid client certificate: %w", user.Name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	token := stringField(user.User, "token")
+	if tokenFile := stringField(user.User, "tokenFile"); token == "" && tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read kubeconfig token file: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	httpClient := &http.Client{
+		Timeout: timeout,
+		Transport: &authTransport{
+			base:     transport,
+			token:    token,
+			username: stringField(user.User, "username"),
+			password: stringField(user.User, "password"),
+		},
+	}
+
+	return strings.TrimRight(server, "/"), httpClient, nil
+}
+
+// authTransport adds bearer token or basic auth headers to requests
+type authTransport struct {
+	base     http.RoundTripper
+	token    string
+	username string
+	password string
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token == "" && t.username == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	} else {
+		req.SetBasicAuth(t.username, t.password)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// stringField reads a string value from a cluster or user body
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// boolField reads a bool value from a cluster or user body
+func boolField(m map[string]interface{}, key string) bool {
+	b, _ := m[key].(bool)
+	return b
+}
+
+// dataField reads a PEM value given either inline as <key>-data (base64) or
+// as a file path under <key>. It returns nil if neither is set.
+func dataField(m map[string]interface{}, key string) ([]byte, error) {
+	if encoded := stringField(m, key+"-data"); encoded != "" {
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig field %s-data is not valid base64: %w", key, err)
+		}
+		return data, nil
+	}
+
+	if path := stringField(m, key); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubeconfig %s: %w", key, err)
+		}
+		return data, nil
+	}
+
+	return nil, nil
+}
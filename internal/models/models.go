@@ -15,7 +15,9 @@ Name                string `json:"name"`
 DisplayName         string `json:"displayName"`
 State               string `json:"state"`
 ZoneName            string `json:"zoneName"`
+TemplateUUID        string `json:"templateUuid"`
 TemplateName        string `json:"templateName"`
+ServiceOfferingUUID string `json:"serviceOfferingUuid"`
 ServiceOfferingName string `json:"serviceOfferingName"`
 Created             string `json:"created"`
 MemoryMB            string `json:"memory"`
@@ -24,9 +26,11 @@ CPUSpeed            int    `json:"cpuSpeed"`
 IPAddress           string `json:"publicIpAddress"`
 CPUCore             string `json:"cpuCore"`
 PrivateIP           string `json:"instancePrivateIp"`
+NetworkUUID         string `json:"networkUuid"`
 NetworkName         string `json:"networkName"`
 VolumeSize          string `json:"volumeSize"`
 Status              string `json:"status"`
+Tags                []Tag  `json:"tags,omitempty"`
 }
 
 // ListInstanceResponse wraps the instance list response
@@ -48,6 +52,27 @@ SSHKeyName          string `json:"sshKeyName,omitempty"`
 SecurityGroupName   string `json:"securitygroupName,omitempty"`
 }
 
+// VMSnapshot represents a snapshot of a whole instance
+type VMSnapshot struct {
+UUID               string `json:"uuid"`
+Name               string `json:"name"`
+Description        string `json:"description"`
+State              string `json:"state"`
+Type               string `json:"type"` // Disk or DiskAndMemory
+Current            bool   `json:"current"`
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+VirtualMachineName string `json:"virtualmachineName"`
+Created            string `json:"created"`
+}
+
+// CreateVMSnapshotRequest represents a request to snapshot an instance
+type CreateVMSnapshotRequest struct {
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+Name               string `json:"name,omitempty"`
+Description        string `json:"description,omitempty"`
+SnapshotMemory     bool   `json:"snapshotMemory"`
+}
+
 // ComputeOffering represents a compute size/flavor
 type ComputeOffering struct {
 UUID          string `json:"uuid"`
@@ -60,6 +85,82 @@ StorageType   string `json:"storageType"`
 IsActive      bool   `json:"isActive"`
 }
 
+// Volume represents a block storage volume
+type Volume struct {
+UUID               string `json:"uuid"`
+Name               string `json:"name"`
+State              string `json:"state"`
+Type               string `json:"volumeType"`
+Size               int64  `json:"size"`
+ZoneName           string `json:"zoneName"`
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+VirtualMachineName string `json:"virtualmachineName"`
+DiskOfferingUUID   string `json:"diskOfferingUuid"`
+DiskOfferingName   string `json:"diskOfferingName"`
+DeviceID           int    `json:"deviceId"`
+Created            string `json:"created"`
+}
+
+// CreateVolumeRequest represents a request to create a data volume
+type CreateVolumeRequest struct {
+Name             string `json:"name"`
+DiskOfferingUUID string `json:"diskOfferingUuid,omitempty"`
+SnapshotUUID     string `json:"snapshotUuid,omitempty"` // Restore from a volume snapshot
+Size             int64  `json:"diskSize,omitempty"`     // GB, custom offerings only
+ZoneUUID         string `json:"zoneUuid"`
+Region           string `json:"-"` // Internal field
+}
+
+// DiskOffering represents a disk size/storage tier volumes are created from
+type DiskOffering struct {
+UUID                 string `json:"uuid"`
+Name                 string `json:"name"`
+DisplayText          string `json:"displayText"`
+DiskSize             int64  `json:"diskSize"` // GB, 0 for custom offerings
+IsCustomDiskOffering bool   `json:"isCustomDiskOffering"`
+StorageType          string `json:"storageType"`
+IsActive             bool   `json:"isActive"`
+}
+
+// Snapshot represents a point-in-time copy of a volume
+type Snapshot struct {
+UUID       string `json:"uuid"`
+Name       string `json:"name"`
+State      string `json:"status"`
+Type       string `json:"snapshotType"` // MANUAL, HOURLY or DAILY
+VolumeUUID string `json:"volumeUuid"`
+VolumeName string `json:"volumeName"`
+VolumeType string `json:"volumeType"`
+Size       int64  `json:"physicalSize"`
+ZoneName   string `json:"zoneName"`
+Created    string `json:"created"`
+}
+
+// CreateSnapshotRequest represents a request to snapshot a volume
+type CreateSnapshotRequest struct {
+Name       string `json:"name,omitempty"`
+VolumeUUID string `json:"volumeUuid"`
+}
+
+// SnapshotPolicy represents a recurring snapshot schedule on a volume
+type SnapshotPolicy struct {
+UUID         string `json:"uuid"`
+VolumeUUID   string `json:"volumeUuid"`
+IntervalType string `json:"intervalType"` // HOURLY or DAILY
+Schedule     string `json:"schedule"`     // "MM" for hourly, "MM:HH" for daily
+MaxSnaps     int    `json:"maxSnaps"`
+Timezone     string `json:"timezone"`
+}
+
+// CreateSnapshotPolicyRequest represents a request to set a snapshot policy
+type CreateSnapshotPolicyRequest struct {
+VolumeUUID   string `json:"volumeUuid"`
+IntervalType string `json:"intervalType"`
+Schedule     string `json:"schedule"`
+MaxSnaps     int    `json:"maxSnaps"`
+Timezone     string `json:"timezone"`
+}
+
 // Template represents an OS image
 type Template struct {
 UUID        string `json:"uuid"`
@@ -68,37 +169,361 @@ Description string `json:"description"`
 OsTypeName  string `json:"osCategoryName"`
 ZoneName    string `json:"zoneName"`
 IsReady     bool   `json:"isActive"`
+Status      string `json:"status"` // Download/upload progress, e.g. "45% Downloaded"
+Format      string `json:"format"`
+Size        int64  `json:"size"`
+Created     string `json:"created"`
+}
+
+// RegisterTemplateRequest represents a request to register an image from a URL
+type RegisterTemplateRequest struct {
+Name        string `json:"name"`
+DisplayText string `json:"displayText"`
+URL         string `json:"url"`
+OsTypeUUID  string `json:"osTypeUuid"`
+Format      string `json:"format"`
+ZoneUUID    string `json:"zoneUuid"`
+Region      string `json:"-"` // Internal field
+}
+
+// TemplateExtract represents an export of an image to a download URL
+type TemplateExtract struct {
+UUID             string `json:"uuid"`
+TemplateUUID     string `json:"templateUuid"`
+State            string `json:"state"`
+Status           string `json:"status"`
+URL              string `json:"url"`
+UploadPercentage int    `json:"uploadPercentage"`
+}
+
+// OSType represents an operating system type images can be registered as
+type OSType struct {
+UUID         string `json:"uuid"`
+Description  string `json:"description"`
+CategoryName string `json:"osCategoryName"`
+}
+
+// CreateTemplateRequest represents a request to create a template from a volume or snapshot
+type CreateTemplateRequest struct {
+Name         string `json:"name"`
+DisplayText  string `json:"displayText"`
+VolumeUUID   string `json:"volumeUuid,omitempty"`
+SnapshotUUID string `json:"snapshotUuid,omitempty"`
+ZoneUUID     string `json:"zoneUuid"`
+Region       string `json:"-"` // Internal field
 }
 
 // Network represents a virtual network
 type Network struct {
+UUID                string `json:"uuid"`
+Name                string `json:"name"`
+DisplayText         string `json:"displayText"`
+ZoneName            string `json:"zoneName"`
+State               string `json:"state"`
+Cidr                string `json:"cidr"`
+Gateway             string `json:"gateway"`
+Type                string `json:"type"`
+Netmask             string `json:"netmask"`
+NetworkDomain       string `json:"networkDomain"`
+NetworkOfferingUUID string `json:"networkOfferingUuid"`
+NetworkOfferingName string `json:"networkOfferingName"`
+DNS1                string `json:"dns1"`
+DNS2                string `json:"dns2"`
+Created             string `json:"created"`
+VpcUUID             string `json:"vpcUuid,omitempty"`
+VpcName             string `json:"vpcName,omitempty"`
+ACLUUID             string `json:"aclUuid,omitempty"`
+ACLName             string `json:"aclName,omitempty"`
+Tags                []Tag  `json:"tags,omitempty"`
+}
+
+// NetworkOffering represents a network offering networks can be created from
+type NetworkOffering struct {
 UUID        string `json:"uuid"`
 Name        string `json:"name"`
 DisplayText string `json:"displayText"`
-ZoneName    string `json:"zoneName"`
+GuestIPType string `json:"guestIpType"`
 State       string `json:"state"`
-Cidr        string `json:"cidr"`
+IsDefault   bool   `json:"isDefault"`
+}
+
+// CreateNetworkRequest represents a request to create a network
+type CreateNetworkRequest struct {
+Name                string `json:"name"`
+DisplayText         string `json:"displayText,omitempty"`
+ZoneUUID            string `json:"zoneUuid"`
+Region              string `json:"-"` // Internal field
+NetworkOfferingUUID string `json:"networkOfferingUuid"`
+Cidr                string `json:"cidr"`
+Gateway             string `json:"gateway"`
+Netmask             string `json:"netmask"`
+VpcUUID             string `json:"vpcUuid,omitempty"`
+ACLUUID             string `json:"aclUuid,omitempty"`
+}
+
+// UpdateNetworkRequest represents a request to update a network
+type UpdateNetworkRequest struct {
+UUID                string `json:"uuid"`
+Name                string `json:"name,omitempty"`
+DisplayText         string `json:"displayText,omitempty"`
+NetworkOfferingUUID string `json:"networkOfferingUuid,omitempty"`
+}
+
+// VPC represents a virtual private cloud grouping network tiers
+type VPC struct {
+UUID          string `json:"uuid"`
+Name          string `json:"name"`
+Description   string `json:"description"`
+Cidr          string `json:"cidr"`
+State         string `json:"state"`
+ZoneName      string `json:"zoneName"`
+NetworkDomain string `json:"networkDomain"`
+Created       string `json:"created"`
+}
+
+// CreateVPCRequest represents a request to create a VPC
+type CreateVPCRequest struct {
+Name          string `json:"name"`
+Description   string `json:"description,omitempty"`
+Cidr          string `json:"cidr"`
+NetworkDomain string `json:"networkDomain,omitempty"`
+ZoneUUID      string `json:"zoneUuid"`
+Region        string `json:"-"` // Internal field
+}
+
+// NetworkACL represents an access control list applied to VPC tiers
+type NetworkACL struct {
+UUID        string `json:"uuid"`
+Name        string `json:"name"`
+Description string `json:"description"`
+VpcUUID     string `json:"vpcUuid"`
+}
+
+// CreateNetworkACLRequest represents a request to create an ACL in a VPC
+type CreateNetworkACLRequest struct {
+Name        string `json:"name"`
+Description string `json:"description,omitempty"`
+VpcUUID     string `json:"vpcUuid"`
+}
+
+// NetworkACLRule represents a single numbered entry of a network ACL
+type NetworkACLRule struct {
+UUID        string `json:"uuid"`
+ACLUUID     string `json:"aclUuid"`
+Number      int    `json:"ruleNumber"`
+Protocol    string `json:"protocol"`
+StartPort   string `json:"startPort"`
+EndPort     string `json:"endPort"`
+CidrList    string `json:"cidrList"`
+Action      string `json:"action"`
+TrafficType string `json:"trafficType"`
+IcmpType    string `json:"icmpType,omitempty"`
+IcmpCode    string `json:"icmpCode,omitempty"`
+State       string `json:"status"`
+}
+
+// CreateNetworkACLRuleRequest represents a request to add a rule to a network ACL
+type CreateNetworkACLRuleRequest struct {
+ACLUUID     string `json:"aclUuid"`
+Number      int    `json:"ruleNumber"`
+Protocol    string `json:"protocol"`
+StartPort   int    `json:"startPort,omitempty"`
+EndPort     int    `json:"endPort,omitempty"`
+CidrList    string `json:"cidrList"`
+Action      string `json:"action"`
+TrafficType string `json:"trafficType"`
+IcmpType    *int   `json:"icmpType,omitempty"`
+IcmpCode    *int   `json:"icmpCode,omitempty"`
+}
+
+// VPNGateway represents the VPN endpoint of a VPC
+type VPNGateway struct {
+UUID     string `json:"uuid"`
+VpcUUID  string `json:"vpcUuid"`
+VpcName  string `json:"vpcName"`
+PublicIP string `json:"publicIp"`
+State    string `json:"state"`
+ZoneName string `json:"zoneName"`
+}
+
+// VPNCustomerGateway represents the remote (on-premises) end of a VPN tunnel
+type VPNCustomerGateway struct {
+UUID        string `json:"uuid"`
+Name        string `json:"name"`
+Gateway     string `json:"gateway"`
+CidrList    string `json:"cidrList"`
+IKEPolicy   string `json:"ikePolicy"`
+ESPPolicy   string `json:"espPolicy"`
+IKELifetime int    `json:"ikeLifetime"`
+ESPLifetime int    `json:"espLifetime"`
+IKEVersion  string `json:"ikeVersion"`
+DPD         bool   `json:"dpd"`
+}
+
+// CreateVPNCustomerGatewayRequest represents a request to register a customer gateway
+type CreateVPNCustomerGatewayRequest struct {
+Name        string `json:"name"`
 Gateway     string `json:"gateway"`
-Type        string `json:"type"`
+CidrList    string `json:"cidrList"`
+IPSecPSK    string `json:"ipsecPsk"`
+IKEPolicy   string `json:"ikePolicy"`
+ESPPolicy   string `json:"espPolicy"`
+IKELifetime int    `json:"ikeLifetime"`
+ESPLifetime int    `json:"espLifetime"`
+IKEVersion  string `json:"ikeVersion"`
+DPD         bool   `json:"dpd"`
+ZoneUUID    string `json:"zoneUuid"`
+Region      string `json:"-"` // Internal field
+}
+
+// VPNConnection represents a site-to-site tunnel between a VPN gateway and a customer gateway
+type VPNConnection struct {
+UUID                string `json:"uuid"`
+VPNGatewayUUID      string `json:"s2sVpnGatewayUuid"`
+CustomerGatewayUUID string `json:"s2sCustomerGatewayUuid"`
+CustomerGatewayName string `json:"s2sCustomerGatewayName"`
+PublicIP            string `json:"publicIp"`
+Gateway             string `json:"gateway"`
+CidrList            string `json:"cidrList"`
+State               string `json:"state"`
+Passive             bool   `json:"passive"`
+Created             string `json:"created"`
+}
+
+// CreateVPNConnectionRequest represents a request to connect a VPN gateway to a customer gateway
+type CreateVPNConnectionRequest struct {
+VPNGatewayUUID      string `json:"s2sVpnGatewayUuid"`
+CustomerGatewayUUID string `json:"s2sCustomerGatewayUuid"`
+Passive             bool   `json:"passive"`
 }
 
 // IPAddress represents a public IP address
 type IPAddress struct {
-UUID        string `json:"uuid"`
-IpAddress   string `json:"publicIpAddress"`
-State       string `json:"state"`
-ZoneName    string `json:"zoneName"`
-IsStaticNat bool   `json:"isSourcenat"`
+UUID               string `json:"uuid"`
+IpAddress          string `json:"publicIpAddress"`
+State              string `json:"state"`
+ZoneName           string `json:"zoneName"`
+IsSourceNat        bool   `json:"isSourcenat"` // Source NAT address of its network
+StaticNatEnabled   bool   `json:"isStaticnat"`  // Static NAT to VirtualMachineUUID
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+VirtualMachineName string `json:"virtualmachineName"`
+NetworkUUID        string `json:"networkUuid"`
+NetworkName        string `json:"networkName"`
+Tags               []Tag  `json:"tags,omitempty"`
 }
 
 // FirewallRule represents a firewall rule
 type FirewallRule struct {
-UUID      string `json:"uuid"`
-Protocol  string `json:"protocol"`
-StartPort string `json:"startPort"`
-EndPort   string `json:"endPort"`
-CidrList  string `json:"cidrList"`
-State     string `json:"status"`
+UUID          string `json:"uuid"`
+Protocol      string `json:"protocol"`
+StartPort     string `json:"startPort"`
+EndPort       string `json:"endPort"`
+CidrList      string `json:"cidrList"`
+State         string `json:"status"`
+IPAddressUUID string `json:"ipAddressUuid"`
+IPAddress     string `json:"ipAddress"`
+IcmpType      string `json:"icmpType,omitempty"`
+IcmpCode      string `json:"icmpCode,omitempty"`
+}
+
+// CreateFirewallRuleRequest represents a request to create a firewall rule
+type CreateFirewallRuleRequest struct {
+IPAddressUUID string `json:"ipAddressUuid"`
+Protocol      string `json:"protocol"`
+StartPort     int    `json:"startPort,omitempty"`
+EndPort       int    `json:"endPort,omitempty"`
+CidrList      string `json:"cidrList"`
+IcmpType      *int   `json:"icmpType,omitempty"`
+IcmpCode      *int   `json:"icmpCode,omitempty"`
+}
+
+// PortForwardingRule forwards a public port range on an IP to an instance
+type PortForwardingRule struct {
+UUID               string `json:"uuid"`
+IPAddressUUID      string `json:"ipAddressUuid"`
+IPAddress          string `json:"ipAddress"`
+Protocol           string `json:"protocol"`
+PublicStartPort    string `json:"publicStartPort"`
+PublicEndPort      string `json:"publicEndPort"`
+PrivateStartPort   string `json:"privateStartPort"`
+PrivateEndPort     string `json:"privateEndPort"`
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+VirtualMachineName string `json:"virtualmachineName"`
+State              string `json:"status"`
+}
+
+// CreatePortForwardingRuleRequest represents a request to create a port forwarding rule
+type CreatePortForwardingRuleRequest struct {
+IPAddressUUID      string `json:"ipAddressUuid"`
+Protocol           string `json:"protocol"`
+PublicStartPort    int    `json:"publicStartPort"`
+PublicEndPort      int    `json:"publicEndPort"`
+PrivateStartPort   int    `json:"privateStartPort"`
+PrivateEndPort     int    `json:"privateEndPort"`
+VirtualMachineUUID string `json:"virtualmachineUuid"`
+}
+
+// LoadBalancerRule represents a load balancer listening on a public IP
+type LoadBalancerRule struct {
+UUID            string `json:"uuid"`
+Name            string `json:"name"`
+Description     string `json:"description"`
+IPAddressUUID   string `json:"ipAddressUuid"`
+IPAddress       string `json:"ipAddress"`
+Protocol        string `json:"protocol"`
+PublicPort      string `json:"publicPort"`
+PrivatePort     string `json:"privatePort"`
+Algorithm       string `json:"algorithm"`
+StickyMethod    string `json:"stickyMethod"`
+HealthCheckPath string `json:"healthCheckPath"`
+State           string `json:"status"`
+ZoneName        string `json:"zoneName"`
+}
+
+// LoadBalancerMember represents an instance assigned to a load balancer
+type LoadBalancerMember struct {
+UUID      string `json:"virtualmachineUuid"`
+Name      string `json:"virtualmachineName"`
+PrivateIP string `json:"ipAddress"`
+State     string `json:"state"`
+}
+
+// CreateLoadBalancerRequest represents a request to create a load balancer
+type CreateLoadBalancerRequest struct {
+Name          string `json:"name"`
+Description   string `json:"description,omitempty"`
+IPAddressUUID string `json:"ipAddressUuid"`
+Protocol      string `json:"protocol"`
+PublicPort    int    `json:"publicPort"`
+PrivatePort   int    `json:"privatePort"`
+Algorithm     string `json:"algorithm"`
+ZoneUUID      string `json:"zoneUuid"`
+Region        string `json:"-"` // Internal field
+}
+
+// UpdateLoadBalancerRequest represents a request to update a load balancer
+type UpdateLoadBalancerRequest struct {
+UUID        string `json:"uuid"`
+Name        string `json:"name,omitempty"`
+Description string `json:"description,omitempty"`
+Algorithm   string `json:"algorithm,omitempty"`
+}
+
+// StickinessPolicyRequest represents a request to set session stickiness on a load balancer
+type StickinessPolicyRequest struct {
+LoadBalancerUUID string `json:"loadBalancerRuleUuid"`
+Name             string `json:"name"`
+Method           string `json:"methodName"`
+}
+
+// HealthCheckPolicyRequest represents a request to set the health check of a load balancer
+type HealthCheckPolicyRequest struct {
+LoadBalancerUUID   string `json:"loadBalancerRuleUuid"`
+PingPath           string `json:"pingPath"`
+Interval           int    `json:"intervalTime"`
+ResponseTimeout    int    `json:"responseTime"`
+HealthyThreshold   int    `json:"healthyThreshold"`
+UnhealthyThreshold int    `json:"unhealthyThreshold"`
 }
 
 // KubernetesVersion represents an available Kubernetes version
@@ -121,6 +546,8 @@ ZoneName         string `json:"zoneName"`
 Size             int    `json:"size"`
 ControlNodes     int    `json:"controlNodes"`
 KubernetesVersion string `json:"kubernetesVersion"`
+IPAddress        string `json:"ipAddress"`
+Tags             []Tag  `json:"tags,omitempty"`
 }
 
 // CreateKubernetesRequest represents a request to create a Kubernetes cluster
@@ -138,3 +565,119 @@ HAEnabled           bool   `json:"haEnabled"`
 SSHKeyName          string `json:"sshKeyName,omitempty"`
 NodeRootDiskSize    int64  `json:"nodeRootDiskSize,omitempty"`
 }
+
+// Tag represents a key/value tag attached to a resource
+type Tag struct {
+Key          string `json:"key"`
+Value        string `json:"value"`
+ResourceType string `json:"resourceType,omitempty"`
+ResourceUUID string `json:"resourceUuid,omitempty"`
+}
+
+// TagsRequest represents a request to add or remove tags on a resource
+type TagsRequest struct {
+ResourceType string `json:"resourceType"`
+ResourceUUID string `json:"resourceUuid"`
+Tags         []Tag  `json:"tags"`
+}
+
+// SSHKeyPair represents an SSH key pair registered with the platform
+type SSHKeyPair struct {
+UUID        string `json:"uuid"`
+Name        string `json:"name"`
+Fingerprint string `json:"fingerPrint"`
+PublicKey   string `json:"publicKey,omitempty"`
+}
+
+// ImportSSHKeyRequest represents a request to register a public key
+type ImportSSHKeyRequest struct {
+Name      string `json:"name"`
+PublicKey string `json:"publicKey"`
+ZoneUUID  string `json:"zoneUuid"`
+Region    string `json:"-"` // Internal field
+}
+
+// InstanceConsole represents a web console session for an instance
+type InstanceConsole struct {
+URL   string `json:"url"`
+Token string `json:"token,omitempty"`
+}
+
+// KubernetesNode represents a VM that is part of a Kubernetes cluster
+type KubernetesNode struct {
+UUID         string `json:"uuid"`
+Name         string `json:"name"`
+Role         string `json:"nodeType"`
+State        string `json:"state"`
+PrivateIP    string `json:"privateIpAddress"`
+PublicIP     string `json:"publicIpAddress"`
+NodePoolName string `json:"nodePoolName"`
+}
+
+// KubernetesTaint represents a taint applied to the nodes of a node pool
+type KubernetesTaint struct {
+Key    string `json:"key"`
+Value  string `json:"value,omitempty"`
+Effect string `json:"effect"`
+}
+
+// KubernetesNodePool represents a group of worker nodes sharing a compute offering
+type KubernetesNodePool struct {
+UUID                string            `json:"uuid"`
+Name                string            `json:"name"`
+ClusterUUID         string            `json:"clusterUuid"`
+ComputeOfferingName string            `json:"computeOfferingName"`
+Size                int               `json:"size"`
+State               string            `json:"state"`
+Labels              map[string]string `json:"labels,omitempty"`
+Taints              []KubernetesTaint `json:"taints,omitempty"`
+}
+
+// CreateNodePoolRequest represents a request to add a node pool to a cluster
+type CreateNodePoolRequest struct {
+ClusterUUID         string            `json:"clusterUuid"`
+Name                string            `json:"name"`
+ComputeOfferingUUID string            `json:"computeOfferingUuid"`
+Size                int               `json:"size"`
+NodeRootDiskSize    int64             `json:"nodeRootDiskSize,omitempty"`
+Labels              map[string]string `json:"labels,omitempty"`
+Taints              []KubernetesTaint `json:"taints,omitempty"`
+}
+
+// CostEstimateRequest represents a request to price instances or a Kubernetes cluster
+type CostEstimateRequest struct {
+ComputeOfferingUUID   string `json:"computeOfferingUuid"`
+KubernetesVersionUUID string `json:"kubernetesVersionUuid,omitempty"`
+DiskSize              int64  `json:"diskSize,omitempty"` // GB
+Count                 int    `json:"count"`              // Instances, or worker nodes for a cluster
+ControlNodes          int    `json:"controlNodes,omitempty"`
+Hours                 int    `json:"hours"`
+ZoneUUID              string `json:"zoneUuid"`
+Region                string `json:"-"` // Internal field
+}
+
+// CostEstimate represents the price of a set of resources
+type CostEstimate struct {
+Currency    string             `json:"currency"`
+Hours       int                `json:"hours"`
+HourlyCost  float64            `json:"hourlyCost"`
+MonthlyCost float64            `json:"monthlyCost"`
+TotalCost   float64            `json:"totalCost"` // For Hours
+Items       []CostEstimateItem `json:"items"`
+}
+
+// CostEstimateItem represents one priced line of a cost estimate
+type CostEstimateItem struct {
+Description string  `json:"description"`
+Quantity    int     `json:"quantity"`
+HourlyCost  float64 `json:"hourlyCost"`
+TotalCost   float64 `json:"totalCost"`
+}
+
+// ComputeOfferingPrice represents the list price of a compute offering
+type ComputeOfferingPrice struct {
+ComputeOfferingUUID string  `json:"computeOfferingUuid"`
+Currency            string  `json:"currency"`
+HourlyPrice         float64 `json:"hourlyPrice"`
+MonthlyPrice        float64 `json:"monthlyPrice"`
+}